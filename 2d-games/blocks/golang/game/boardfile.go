@@ -0,0 +1,227 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Board is a snapshot of the playfield cells and the upcoming pieces.
+//
+// Boards are stored as text so they can be written by hand:
+//
+//	version 1
+//	width 10
+//	height 20
+//	queue TIO
+//	..........
+//	...
+//	GGGG.GGGGG
+//
+// After the header there is one line per row from top to bottom. '.' is an empty cell,
+// 'G' is a garbage cell and piece symbols (I J L O S T Z, 1 2 3 for the small pieces) are locked cells of that piece.
+type Board struct {
+	Width  int
+	Height int
+	Cells  [][]Cell
+	Queue  []PieceType
+}
+
+const (
+	boardFileVersion = 1
+	emptyCellSymbol  = '.'
+	garbageSymbol    = 'G'
+)
+
+func NewBoard(width, height int) *Board {
+	cells := make([][]Cell, height)
+	for i := range cells {
+		cells[i] = make([]Cell, width)
+	}
+
+	return &Board{
+		Width:  width,
+		Height: height,
+		Cells:  cells,
+	}
+}
+
+func LoadBoard(path string) (*Board, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b, err := ReadBoard(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return b, nil
+}
+
+func (b *Board) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := b.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func ReadBoard(r io.Reader) (*Board, error) {
+	scanner := bufio.NewScanner(r)
+	header := map[string]string{}
+	var rows []string
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// the line is trimmed first, an empty queue header like "queue " is left without a value
+		if key, value, ok := strings.Cut(line, " "); rows == nil && (ok || key == "queue") {
+			header[key] = strings.TrimSpace(value)
+			continue
+		}
+
+		rows = append(rows, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	version, err := headerInt(header, "version")
+	if err != nil {
+		return nil, err
+	}
+
+	if version != boardFileVersion {
+		return nil, fmt.Errorf("unsupported board version %d", version)
+	}
+
+	width, err := headerInt(header, "width")
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := headerInt(header, "height")
+	if err != nil {
+		return nil, err
+	}
+
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid board size %dx%d", width, height)
+	}
+
+	if len(rows) != height {
+		return nil, fmt.Errorf("expected %d rows, found %d", height, len(rows))
+	}
+
+	b := NewBoard(width, height)
+	for y, row := range rows {
+		symbols := []rune(row)
+		if len(symbols) != width {
+			return nil, fmt.Errorf("row %d: expected %d cells, found %d", y+1, width, len(symbols))
+		}
+
+		for x, s := range symbols {
+			c, err := parseCellSymbol(s)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", y+1, err)
+			}
+
+			b.Cells[y][x] = c
+		}
+	}
+
+	for _, s := range header["queue"] {
+		t, ok := ParsePieceType(s)
+		if !ok {
+			return nil, fmt.Errorf("queue: unknown piece '%c'", s)
+		}
+
+		b.Queue = append(b.Queue, t)
+	}
+
+	return b, nil
+}
+
+func (b *Board) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "version %d\n", boardFileVersion)
+	fmt.Fprintf(bw, "width %d\n", b.Width)
+	fmt.Fprintf(bw, "height %d\n", b.Height)
+
+	if len(b.Queue) > 0 {
+		bw.WriteString("queue ")
+		for _, t := range b.Queue {
+			bw.WriteRune(t.Symbol())
+		}
+		bw.WriteRune('\n')
+	}
+
+	for _, row := range b.Cells {
+		for _, c := range row {
+			bw.WriteRune(cellSymbol(c))
+		}
+		bw.WriteRune('\n')
+	}
+
+	return bw.Flush()
+}
+
+func headerInt(header map[string]string, key string) (int, error) {
+	v, ok := header[key]
+	if !ok {
+		return 0, fmt.Errorf("missing '%s'", key)
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid '%s': %w", key, err)
+	}
+
+	return i, nil
+}
+
+func cellSymbol(c Cell) rune {
+	switch {
+	case c.IsEmpty():
+		return emptyCellSymbol
+	case c.Garbage:
+		return garbageSymbol
+	default:
+		return c.Piece.Symbol()
+	}
+}
+
+func parseCellSymbol(s rune) (Cell, error) {
+	switch s {
+	case emptyCellSymbol:
+		return Cell{}, nil
+	case garbageSymbol:
+		return newGarbageCell(0), nil
+	}
+
+	t, ok := ParsePieceType(s)
+	if !ok {
+		return Cell{}, fmt.Errorf("unknown cell '%c'", s)
+	}
+
+	return newPieceCell(t, 0), nil
+}
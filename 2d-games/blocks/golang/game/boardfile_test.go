@@ -0,0 +1,102 @@
+package game_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/blocks/game"
+)
+
+func TestBoardRoundTrip(t *testing.T) {
+	input := strings.Join([]string{
+		"version 1",
+		"width 4",
+		"height 3",
+		"queue TI1",
+		"....",
+		"..O.",
+		"GG.3",
+		"",
+	}, "\n")
+
+	b, err := game.ReadBoard(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Width != 4 || b.Height != 3 {
+		t.Fatalf("unexpected board size %dx%d", b.Width, b.Height)
+	}
+
+	expectedQueue := []game.PieceType{game.PieceTypeT, game.PieceTypeI, game.PieceTypeDot}
+	if len(b.Queue) != len(expectedQueue) {
+		t.Fatalf("queue expected=%v actual=%v", expectedQueue, b.Queue)
+	}
+
+	for i := range expectedQueue {
+		if b.Queue[i] != expectedQueue[i] {
+			t.Fatalf("queue expected=%v actual=%v", expectedQueue, b.Queue)
+		}
+	}
+
+	if c := b.Cells[1][2]; !c.Filled || c.Piece != game.PieceTypeO {
+		t.Errorf("cell (2, 1) expected O piece, actual %+v", c)
+	}
+
+	if c := b.Cells[2][0]; !c.Filled || !c.Garbage {
+		t.Errorf("cell (0, 2) expected garbage, actual %+v", c)
+	}
+
+	if c := b.Cells[0][0]; !c.IsEmpty() {
+		t.Errorf("cell (0, 0) expected empty, actual %+v", c)
+	}
+
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.String() != input {
+		t.Errorf("\nExpected:\n%s\nActual:\n%s", input, buf.String())
+	}
+}
+
+func TestReadBoardEmptyQueue(t *testing.T) {
+	for _, queue := range []string{"queue", "queue ", "queue \t"} {
+		b, err := game.ReadBoard(strings.NewReader("version 1\nwidth 2\nheight 1\n" + queue + "\n.G\n"))
+		if err != nil {
+			t.Fatalf("%q: %s", queue, err)
+		}
+
+		if len(b.Queue) != 0 {
+			t.Errorf("%q: expected an empty queue, actual %v", queue, b.Queue)
+		}
+
+		if c := b.Cells[0][1]; !c.Garbage {
+			t.Errorf("%q: cell (1, 0) expected garbage, actual %+v", queue, c)
+		}
+	}
+}
+
+func TestReadBoardErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"missing version", "width 2\nheight 1\n..\n"},
+		{"unsupported version", "version 9\nwidth 2\nheight 1\n..\n"},
+		{"missing rows", "version 1\nwidth 2\nheight 2\n..\n"},
+		{"short row", "version 1\nwidth 2\nheight 1\n.\n"},
+		{"unknown cell", "version 1\nwidth 2\nheight 1\n.X\n"},
+		{"unknown queue piece", "version 1\nwidth 2\nheight 1\nqueue TX\n..\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := game.ReadBoard(strings.NewReader(tc.input)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
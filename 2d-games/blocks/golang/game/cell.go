@@ -0,0 +1,28 @@
+package game
+
+type Cell struct {
+	Filled   bool
+	Piece    PieceType
+	Garbage  bool
+	LockedAt int
+}
+
+func (c Cell) IsEmpty() bool {
+	return !c.Filled
+}
+
+func newPieceCell(t PieceType, lockedAt int) Cell {
+	return Cell{
+		Filled:   true,
+		Piece:    t,
+		LockedAt: lockedAt,
+	}
+}
+
+func newGarbageCell(lockedAt int) Cell {
+	return Cell{
+		Filled:   true,
+		Garbage:  true,
+		LockedAt: lockedAt,
+	}
+}
@@ -0,0 +1,352 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/tinne26/etxt"
+)
+
+const (
+	finessePanelWidth = 230
+	finessePieces     = 20
+)
+
+// FinesseAction is a single input of a placement. Holding a direction key until it auto repeats
+// moves the piece to the wall and counts as one DAS action.
+type FinesseAction int
+
+const (
+	ActionRotate FinesseAction = iota
+	ActionLeft
+	ActionRight
+	ActionDASLeft
+	ActionDASRight
+)
+
+var finesseActionMessages = map[FinesseAction]MessageKey{
+	ActionRotate:   MessageActionRotate,
+	ActionLeft:     MessageActionLeft,
+	ActionRight:    MessageActionRight,
+	ActionDASLeft:  MessageActionDASLeft,
+	ActionDASRight: MessageActionDASRight,
+}
+
+// ActionRecorder turns the keys pressed in each tick into finesse actions
+type ActionRecorder struct {
+	actions []FinesseAction
+}
+
+func (r *ActionRecorder) Record(key KeyCode, repeat bool) {
+	last := FinesseAction(-1)
+	if len(r.actions) > 0 {
+		last = r.actions[len(r.actions)-1]
+	}
+
+	switch {
+	case key == KeyRotate:
+		r.actions = append(r.actions, ActionRotate)
+	case key == KeyLeft && !repeat:
+		r.actions = append(r.actions, ActionLeft)
+	case key == KeyRight && !repeat:
+		r.actions = append(r.actions, ActionRight)
+	case key == KeyLeft && last == ActionLeft:
+		r.actions[len(r.actions)-1] = ActionDASLeft
+	case key == KeyRight && last == ActionRight:
+		r.actions[len(r.actions)-1] = ActionDASRight
+	}
+}
+
+func (r *ActionRecorder) Actions() []FinesseAction {
+	return r.actions
+}
+
+func (r *ActionRecorder) Reset() {
+	r.actions = r.actions[:0]
+}
+
+// apply performs the action and returns false when the piece could not move
+func (piece *Piece) apply(a FinesseAction) bool {
+	before := piece.key()
+
+	switch a {
+	case ActionRotate:
+		piece.Turn()
+	case ActionLeft:
+		piece.MoveLeft()
+	case ActionRight:
+		piece.MoveRight()
+	case ActionDASLeft:
+		for piece.moved(piece.MoveLeft) {
+		}
+	case ActionDASRight:
+		for piece.moved(piece.MoveRight) {
+		}
+	}
+
+	return piece.key() != before
+}
+
+func (piece *Piece) moved(move func()) bool {
+	before := piece.footprint()
+	move()
+
+	return piece.footprint() != before
+}
+
+func (piece *Piece) clone() *Piece {
+	blocks := make([]*Point, len(*piece.blocks))
+	for i, b := range *piece.blocks {
+		p := *b
+		blocks[i] = &p
+	}
+
+	c := *piece
+	c.blocks = &blocks
+
+	return &c
+}
+
+// footprint identifies the cells covered by the piece
+func (piece *Piece) footprint() string {
+	points := make([]string, len(*piece.blocks))
+	for i, b := range *piece.blocks {
+		points[i] = fmt.Sprintf("%d,%d", b.X, b.Y)
+	}
+	sort.Strings(points)
+
+	return strings.Join(points, " ")
+}
+
+// key identifies the piece state, the rotation index is part of it since it decides the next turn
+func (piece *Piece) key() string {
+	return fmt.Sprintf("%d:%s", piece.rotationIndex, piece.footprint())
+}
+
+func (piece *Piece) dropped() *Piece {
+	c := piece.clone()
+	for c.MoveDown() {
+	}
+
+	return c
+}
+
+// finessePaths searches the shortest action sequence to every position the piece can be dropped to.
+// The result is keyed by the footprint of the dropped piece.
+func finessePaths(piece *Piece) map[string][]FinesseAction {
+	type node struct {
+		piece *Piece
+		path  []FinesseAction
+	}
+
+	result := map[string][]FinesseAction{}
+	visited := map[string]bool{piece.key(): true}
+	queue := []node{{piece.clone(), nil}}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		f := n.piece.dropped().footprint()
+		if _, ok := result[f]; !ok {
+			result[f] = n.path
+		}
+
+		for a := ActionRotate; a <= ActionDASRight; a++ {
+			next := n.piece.clone()
+			if !next.apply(a) || visited[next.key()] {
+				continue
+			}
+
+			visited[next.key()] = true
+			queue = append(queue, node{next, append(slices.Clip(n.path), a)})
+		}
+	}
+
+	return result
+}
+
+// FinesseScene shows a target spot for every piece and checks that it is reached with the fewest inputs.
+type FinesseScene struct {
+	scene      *GameScene
+	retry      bool
+	recorder   ActionRecorder
+	target     *Piece
+	shortest   []FinesseAction
+	fault      bool
+	placements int
+	clean      int
+	message    string
+	text       *TextRenderer
+}
+
+func newFinesseScene(settings Settings) (*FinesseScene, error) {
+	scene, err := newGameScene(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FinesseScene{
+		scene: scene,
+		retry: settings.FinesseRetry,
+		text:  NewTextRenderer(RobotoRegularFontName, color.Black, 14, etxt.Top|etxt.Left),
+	}
+
+	scene.playField.LoadBoard(NewBoard(scene.playField.width, scene.playField.height))
+	s.setTarget()
+
+	return s, nil
+}
+
+func (s *FinesseScene) GetSize() (screenWidth, screenHeight int) {
+	w, h := s.scene.GetSize()
+	return w + finessePanelWidth, h
+}
+
+func (s *FinesseScene) finished() bool {
+	return s.placements >= finessePieces
+}
+
+func (s *FinesseScene) Update() error {
+	g := s.scene
+	if s.finished() {
+		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+			s.placements, s.clean = 0, 0
+			s.message = ""
+			g.setNewPiece()
+			s.setTarget()
+		}
+
+		return nil
+	}
+
+	g.ticks++
+
+	key, repeat := GetKeyPress()
+	key = g.modifyInput(key)
+	s.recorder.Record(key, repeat)
+	g.handleInput(key)
+
+	if len(s.recorder.Actions()) > len(s.shortest) && !s.fault {
+		s.fault = true
+		s.message = g.messages.Get(MessageFinesseFault)
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		for g.currentPiece.MoveDown() {
+		}
+		s.place()
+	} else if g.updateGravity() {
+		s.place()
+	}
+
+	return nil
+}
+
+// place checks the placement of the current piece, the playfield is kept empty so the piece is not locked
+func (s *FinesseScene) place() {
+	g := s.scene
+	if g.currentPiece.footprint() != s.target.footprint() {
+		s.fault = true
+		s.message = g.messages.Get(MessageFinesseWrongSpot)
+	}
+
+	if s.fault && s.retry {
+		s.message += ", " + g.messages.Get(MessageFinesseRetry)
+		g.queue = append([]PieceType{g.currentPiece.pieceType}, g.queue...)
+		g.setNewPiece()
+		s.recorder.Reset()
+		s.fault = false
+		return
+	}
+
+	s.placements++
+	if !s.fault {
+		s.clean++
+		s.message = ""
+	}
+
+	g.setNewPiece()
+	s.setTarget()
+}
+
+func (s *FinesseScene) setTarget() {
+	paths := finessePaths(s.scene.currentPiece)
+	footprints := make([]string, 0, len(paths))
+	for f := range paths {
+		footprints = append(footprints, f)
+	}
+	sort.Strings(footprints)
+
+	f := footprints[rand.Intn(len(footprints))]
+	s.shortest = paths[f]
+
+	// replay the shortest path to get the target piece
+	s.target = s.scene.currentPiece.clone()
+	for _, a := range s.shortest {
+		s.target.apply(a)
+	}
+	s.target = s.target.dropped()
+
+	s.recorder.Reset()
+	s.fault = false
+}
+
+func (s *FinesseScene) percentage() int {
+	if s.placements == 0 {
+		return 100
+	}
+
+	return s.clean * 100 / s.placements
+}
+
+func (s *FinesseScene) actionNames(actions []FinesseAction) string {
+	names := make([]string, len(actions))
+	for i, a := range actions {
+		names[i] = s.scene.messages.Get(finesseActionMessages[a])
+	}
+
+	return strings.Join(names, "\n")
+}
+
+func (s *FinesseScene) Draw(screen *ebiten.Image) {
+	g := s.scene
+	g.Draw(screen)
+
+	ghost := lerpColor(s.target.Color(), g.playField.theme.Empty, 0.6)
+	for _, b := range *s.target.blocks {
+		g.playField.FillBlock(screen, float32(b.X), float32(b.Y), ghost)
+	}
+	g.currentPiece.Draw(screen)
+
+	w, _ := g.GetSize()
+	y := g.nextPieceRect.Min.Y
+
+	s.text.SetColor(color.Black)
+	s.text.Draw(screen, fmt.Sprintf(g.messages.Get(MessageFinesseProgress), min(s.placements+1, finessePieces), finessePieces, s.percentage()), w, y)
+
+	s.text.Draw(screen, g.messages.Get(MessageFinesseShortest)+"\n"+s.actionNames(s.shortest), w, y+60)
+
+	if s.fault {
+		s.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
+	}
+	s.text.Draw(screen, g.messages.Get(MessageFinesseInputs)+"\n"+s.actionNames(s.recorder.Actions()), w+110, y+60)
+
+	if s.message != "" {
+		s.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
+		s.text.Draw(screen, s.message, w, screen.Bounds().Dy()-30)
+	}
+
+	if s.finished() {
+		fillRect(screen, 0, 0, float32(screen.Bounds().Dx()), float32(screen.Bounds().Dy()), color.RGBA{0, 0, 0, 192})
+		g.text.SetAlign(etxt.Center)
+		g.text.SetColor(color.White)
+		g.text.Draw(screen, fmt.Sprintf(g.messages.Get(MessageFinesseResult), s.percentage(), s.clean, s.placements), w/2, screen.Bounds().Dy()/2)
+	}
+}
@@ -0,0 +1,60 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFinessePaths(t *testing.T) {
+	rules := rulePresets[RulesGuideline]
+	p := newPlayField(0, 0, 10, 20, rules.HiddenRows, 1)
+
+	testCases := []struct {
+		name      string
+		pieceType PieceType
+		actions   []FinesseAction
+	}{
+		{"O to the left wall", PieceTypeO, []FinesseAction{ActionDASLeft}},
+		{"O one column right", PieceTypeO, []FinesseAction{ActionRight}},
+		{"T flat in place", PieceTypeT, nil},
+		{"T two columns left", PieceTypeT, []FinesseAction{ActionLeft, ActionLeft}},
+		{"I upright at the right wall", PieceTypeI, []FinesseAction{ActionRotate, ActionDASRight}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			piece := createPiece(p, tc.pieceType)
+			rules.spawn(piece)
+
+			target := piece.clone()
+			for _, a := range tc.actions {
+				target.apply(a)
+			}
+
+			paths := finessePaths(piece)
+			actual, ok := paths[target.dropped().footprint()]
+			if !ok {
+				t.Fatal("target is not reachable")
+			}
+
+			if !slices.Equal(actual, tc.actions) {
+				t.Errorf("expected=%v actual=%v", tc.actions, actual)
+			}
+		})
+	}
+}
+
+func TestActionRecorder(t *testing.T) {
+	var r ActionRecorder
+	r.Record(KeyLeft, false)
+	r.Record(KeyLeft, true)
+	r.Record(KeyLeft, true)
+	r.Record(KeyDown, false)
+	r.Record(KeyRotate, false)
+	r.Record(KeyRight, false)
+
+	expected := []FinesseAction{ActionDASLeft, ActionRotate, ActionRight}
+	if !slices.Equal(r.Actions(), expected) {
+		t.Errorf("expected=%v actual=%v", expected, r.Actions())
+	}
+}
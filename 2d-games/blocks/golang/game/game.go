@@ -7,22 +7,42 @@ import (
 	"github.com/tinne26/etxt"
 )
 
+type scene interface {
+	Update() error
+	Draw(screen *ebiten.Image)
+	GetSize() (screenWidth, screenHeight int)
+}
+
 type Game struct {
-	text      *TextRenderer
-	gameScene *GameScene
+	text  *TextRenderer
+	scene scene
 }
 
-func NewGame() *Game {
+func NewGame(settings Settings) (*Game, error) {
+	var s scene
+	var err error
+	if settings.Sandbox {
+		s, err = newSandboxScene(settings)
+	} else if settings.Finesse {
+		s, err = newFinesseScene(settings)
+	} else {
+		s, err = newGameScene(settings)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
 	g := &Game{
-		text:      NewTextRenderer(RobotoBoldFontName, color.White, 18, etxt.Center),
-		gameScene: newGameScene(),
+		text:  NewTextRenderer(RobotoBoldFontName, color.White, 18, etxt.Center),
+		scene: s,
 	}
 
-	return g
+	return g, nil
 }
 
 func (g *Game) GetSize() (screenWidth, screenHeight int) {
-	return g.gameScene.GetSize()
+	return g.scene.GetSize()
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
@@ -30,11 +50,11 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeigh
 }
 
 func (g *Game) Update() error {
-	g.gameScene.Update()
+	g.scene.Update()
 
 	return nil
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
-	g.gameScene.Draw(screen)
+	g.scene.Draw(screen)
 }
@@ -3,11 +3,9 @@ package game
 import (
 	"image"
 	"image/color"
-	"math"
 	"strconv"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/tinne26/etxt"
 )
 
@@ -18,22 +16,68 @@ type GameScene struct {
 	gameOver        bool
 	gameOverImage   *ebiten.Image
 	moveDownCounter *TicksCounter
+	gravityCurve    *GravityCurve
+	cascade         bool
+	lockDelay       int
+	lockTicks       int
 	text            *TextRenderer
 	nextPieceRect   image.Rectangle
 	score           int
 	lines           int
 	level           int
+	ticks           int
+	queue           []PieceType
+	randomQueue     bool
+	hudImage        *ebiten.Image
+	hudState        hudState
+	hudDirty        bool
+	modifiers       []Modifier
+	messages        *Localizer
+	rules           Rules
 }
 
-func newGameScene() *GameScene {
+func newGameScene(settings Settings) (*GameScene, error) {
+	gravityCurve, err := GetGravityCurve(settings.GravityCurve)
+	if err != nil {
+		return nil, err
+	}
+
+	modifiers, err := ParseModifiers(settings.Modifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := settings.Rules.Validate(); err != nil {
+		return nil, err
+	}
+
 	g := &GameScene{
-		playField:       newPlayField(20, 20, 10, 20, 25),
+		playField:       newPlayField(20, 20, 10, 20, settings.Rules.HiddenRows, 25),
 		gameOver:        false,
 		moveDownCounter: NewTicksCounter(ebiten.TPS()),
+		gravityCurve:    gravityCurve,
+		cascade:         settings.Cascade,
 		text:            NewTextRenderer(RobotoBoldFontName, color.Black, 20, etxt.Center),
 		score:           0,
 		lines:           0,
 		level:           0,
+		randomQueue:     !settings.Sandbox,
+		modifiers:       modifiers,
+		messages:        NewLocalizer(settings.Language),
+		rules:           settings.Rules,
+	}
+
+	g.playField.cellColor = g.cellColor
+
+	if settings.BoardFile != "" {
+		b, err := LoadBoard(settings.BoardFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := g.loadBoard(b); err != nil {
+			return nil, err
+		}
 	}
 
 	playFieldW, _ := g.playField.GetSize()
@@ -44,23 +88,70 @@ func newGameScene() *GameScene {
 	g.gameOverImage = ebiten.NewImage(w, h)
 	g.gameOverImage.Fill(color.RGBA{0, 0, 0, 192})
 	g.text.SetColor(color.Opaque)
-	g.text.Draw(g.gameOverImage, "GAME OVER\nPRESS ANY KEY TO RESTART", g.gameOverImage.Bounds().Dx()/2, g.gameOverImage.Bounds().Dy()/2)
+	g.text.Draw(g.gameOverImage, g.messages.Get(MessageGameOver), g.gameOverImage.Bounds().Dx()/2, g.gameOverImage.Bounds().Dy()/2)
+	g.hudImage = ebiten.NewImage(w, h)
+	g.hudDirty = true
 
+	g.applyLevel()
 	g.setNewPiece()
 
-	return g
+	return g, nil
 }
 
 func (g *GameScene) setNewPiece() bool {
-	if g.currentPiece == nil {
-		g.currentPiece = createNewPiece(g.playField)
+	g.fillQueue()
+
+	if len(g.queue) > 0 {
+		g.currentPiece = createPiece(g.playField, g.queue[0])
+		g.queue = g.queue[1:]
 	} else {
-		g.currentPiece = g.nextPiece
+		g.currentPiece = createNewPiece(g.playField)
+	}
+
+	for _, m := range g.modifiers {
+		m.PieceSpawned(g, g.currentPiece)
+	}
+
+	g.fillQueue()
+	g.updateNextPiece()
+	g.lockTicks = 0
+
+	return g.rules.spawn(g.currentPiece)
+}
+
+func (g *GameScene) fillQueue() {
+	if g.randomQueue && len(g.queue) == 0 {
+		g.queue = append(g.queue, randomPieceType())
+	}
+}
+
+func (g *GameScene) updateNextPiece() {
+	g.nextPiece = nil
+	if len(g.queue) > 0 {
+		g.nextPiece = createPiece(g.playField, g.queue[0])
+	}
+}
+
+func (g *GameScene) loadBoard(b *Board) error {
+	if err := g.playField.LoadBoard(b); err != nil {
+		return err
 	}
 
-	g.nextPiece = createNewPiece(g.playField)
+	g.queue = append(g.queue[:0], b.Queue...)
+	g.updateNextPiece()
 
-	return !g.currentPiece.collides()
+	return nil
+}
+
+// board returns the playfield with the current piece at the head of the queue
+func (g *GameScene) board() *Board {
+	b := g.playField.Board()
+	if g.currentPiece != nil {
+		b.Queue = append(b.Queue, g.currentPiece.pieceType)
+	}
+	b.Queue = append(b.Queue, g.queue...)
+
+	return b
 }
 
 func (g *GameScene) GetSize() (screenWidth, screenHeight int) {
@@ -73,7 +164,48 @@ func (g *GameScene) Update() error {
 		return nil
 	}
 
-	switch GetKeyPressed() {
+	g.ticks++
+	g.handleInput(g.input())
+
+	if g.updateModifiers() && g.updateGravity() {
+		g.lockPiece()
+	}
+
+	return nil
+}
+
+func (g *GameScene) input() KeyCode {
+	return g.modifyInput(GetKeyPressed())
+}
+
+func (g *GameScene) modifyInput(key KeyCode) KeyCode {
+	for _, m := range g.modifiers {
+		key = m.Input(g, key)
+	}
+
+	return key
+}
+
+// updateModifiers runs the modifier updates and returns false when one of them ended the game
+func (g *GameScene) updateModifiers() bool {
+	for _, m := range g.modifiers {
+		m.Update(g)
+	}
+
+	return !g.gameOver
+}
+
+func (g *GameScene) cellColor(c Cell) color.Color {
+	clr := g.playField.theme.CellColor(c)
+	for _, m := range g.modifiers {
+		clr = m.CellColor(g, c, clr)
+	}
+
+	return clr
+}
+
+func (g *GameScene) handleInput(key KeyCode) {
+	switch key {
 	case KeyRotate:
 		g.currentPiece.Turn()
 	case KeyLeft:
@@ -83,37 +215,84 @@ func (g *GameScene) Update() error {
 	case KeyDown:
 		g.currentPiece.MoveDown()
 	}
+}
 
-	canMoveDown := true
-	if g.moveDownCounter.Update() {
-		canMoveDown = g.currentPiece.MoveDown()
+func (g *GameScene) lockPiece() {
+	lockedOut := g.rules.lockedOut(g.currentPiece)
+	g.currentPiece.AbsorbIntoPlayField(g.ticks)
+	if lockedOut {
+		g.gameOver = true
+		return
 	}
 
-	if !canMoveDown {
-		g.currentPiece.AbsorbIntoPlayField()
-		if l := g.playField.ClearLines(); l > 0 {
-			f := func(n int) int {
-				result := 1
-				for i := 2; i <= n; i++ {
-					result *= i
-				}
-				return result
-			}
-			g.score += 50 * f(l) * (g.level + 1)
+	if chains := g.clearLines(); len(chains) > 0 {
+		for i, l := range chains {
+			g.score += lineClearScore(l, g.level, i+1)
 			g.lines += l
-			g.level = g.lines / 10
-
-			tps := float64(ebiten.TPS())
-			g.moveDownCounter.SetTicks(int(math.Max(1.0, 4.0*tps/float64(g.level+4))))
-		}
-		if !g.setNewPiece() {
-			g.gameOver = true
 		}
+		g.level = g.gravityCurve.LevelForLines(g.lines)
+		g.applyLevel()
+	}
+	if !g.setNewPiece() {
+		g.gameOver = true
+	}
+}
+
+// clearLines returns the number of lines cleared by each chain step, a chain has a single step unless cascade gravity is on
+func (g *GameScene) clearLines() []int {
+	if g.cascade {
+		return g.playField.ClearLinesCascade()
+	}
+
+	if l := g.playField.ClearLines(); l > 0 {
+		return []int{l}
 	}
 
 	return nil
 }
 
+func lineClearScore(lines, level, chain int) int {
+	f := func(n int) int {
+		result := 1
+		for i := 2; i <= n; i++ {
+			result *= i
+		}
+		return result
+	}
+
+	return 50 * f(lines) * (level + 1) * chain
+}
+
+// updateGravity moves the current piece down by the rows due in this tick and returns true when the piece has to lock
+func (g *GameScene) updateGravity() bool {
+	rows := g.moveDownCounter.Advance()
+	canMoveDown := true
+	for i := 0; i < rows && canMoveDown; i++ {
+		canMoveDown = g.currentPiece.MoveDown()
+	}
+
+	if g.lockDelay == 0 {
+		return !canMoveDown
+	}
+
+	if !g.currentPiece.IsGrounded() {
+		g.lockTicks = 0
+		return false
+	}
+
+	g.lockTicks++
+
+	return g.lockTicks >= g.lockDelay
+}
+
+func (g *GameScene) applyLevel() {
+	tps := ebiten.TPS()
+	spec := g.gravityCurve.Level(g.level)
+
+	g.moveDownCounter.SetRate(spec.Gravity.rate(tps))
+	g.lockDelay = spec.LockDelay * tps / gravityFrameRate
+}
+
 func (g *GameScene) Draw(screen *ebiten.Image) {
 	screen.Fill(color.RGBA{R: 225, G: 225, B: 225, A: 255})
 	g.playField.Draw(screen)
@@ -122,10 +301,45 @@ func (g *GameScene) Draw(screen *ebiten.Image) {
 		g.currentPiece.Draw(screen)
 	}
 
+	g.drawHUD(screen)
+
+	if g.gameOver {
+		drawImage(screen, g.gameOverImage, nil)
+	}
+}
+
+// hudState holds the values shown on the HUD, the HUD image is only redrawn when they change
+type hudState struct {
+	score     int
+	level     int
+	lines     int
+	nextPiece *Piece
+}
+
+func (g *GameScene) drawHUD(screen *ebiten.Image) {
+	s := hudState{
+		score:     g.score,
+		level:     g.level,
+		lines:     g.lines,
+		nextPiece: g.nextPiece,
+	}
+
+	if g.hudDirty || s != g.hudState {
+		g.hudState = s
+		g.hudDirty = false
+		g.renderHUD(g.hudImage)
+	}
+
+	drawImage(screen, g.hudImage, nil)
+}
+
+func (g *GameScene) renderHUD(screen *ebiten.Image) {
+	screen.Clear()
+
 	g.text.SetAlign(etxt.Top | etxt.Left)
 	g.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
-	g.text.Draw(screen, "NEXT", g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y-25)
-	vector.DrawFilledRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y), float32(g.nextPieceRect.Dx()), float32(g.nextPieceRect.Dy()), g.playField.emptyColor, false)
+	g.text.Draw(screen, g.messages.Get(MessageNext), g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y-25)
+	fillRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y), float32(g.nextPieceRect.Dx()), float32(g.nextPieceRect.Dy()), g.playField.theme.Empty)
 
 	if g.nextPiece != nil {
 		rect := g.nextPiece.getRectangle()
@@ -133,13 +347,13 @@ func (g *GameScene) Draw(screen *ebiten.Image) {
 		y := float32(6-rect.Dy()) / 2
 
 		for _, p := range *g.nextPiece.blocks {
-			vector.DrawFilledRect(screen, float32(g.nextPieceRect.Min.X)+((float32(p.X)+x)*float32(g.playField.tileSize)), float32(g.nextPieceRect.Min.Y)+((float32(p.Y)+y)*float32(g.playField.tileSize)), float32(g.playField.tileSize), float32(g.playField.tileSize), g.nextPiece.color, false)
+			fillRect(screen, float32(g.nextPieceRect.Min.X)+((float32(p.X)+x)*float32(g.playField.tileSize)), float32(g.nextPieceRect.Min.Y)+((float32(p.Y)+y)*float32(g.playField.tileSize)), float32(g.playField.tileSize), float32(g.playField.tileSize), g.nextPiece.Color())
 		}
 	}
 
 	g.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
-	g.text.Draw(screen, "SCORE", g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+15)
-	vector.DrawFilledRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+40), float32(g.nextPieceRect.Dx()), 35, g.playField.emptyColor, false)
+	g.text.Draw(screen, g.messages.Get(MessageScore), g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+15)
+	fillRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+40), float32(g.nextPieceRect.Dx()), 35, g.playField.theme.Empty)
 
 	g.text.SetColor(color.White)
 	g.text.SetAlign(etxt.Right)
@@ -147,8 +361,8 @@ func (g *GameScene) Draw(screen *ebiten.Image) {
 
 	g.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
 	g.text.SetAlign(etxt.Top | etxt.Left)
-	g.text.Draw(screen, "LEVEL", g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+90)
-	vector.DrawFilledRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+115), float32(g.nextPieceRect.Dx()), 35, g.playField.emptyColor, false)
+	g.text.Draw(screen, g.messages.Get(MessageLevel), g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+90)
+	fillRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+115), float32(g.nextPieceRect.Dx()), 35, g.playField.theme.Empty)
 
 	g.text.SetColor(color.White)
 	g.text.SetAlign(etxt.Right)
@@ -156,14 +370,11 @@ func (g *GameScene) Draw(screen *ebiten.Image) {
 
 	g.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
 	g.text.SetAlign(etxt.Top | etxt.Left)
-	g.text.Draw(screen, "LINES", g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+165)
-	vector.DrawFilledRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+190), float32(g.nextPieceRect.Dx()), 35, g.playField.emptyColor, false)
+	g.text.Draw(screen, g.messages.Get(MessageLines), g.nextPieceRect.Min.X, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+165)
+	fillRect(screen, float32(g.nextPieceRect.Min.X), float32(g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+190), float32(g.nextPieceRect.Dx()), 35, g.playField.theme.Empty)
 
 	g.text.SetColor(color.White)
 	g.text.SetAlign(etxt.Right)
 	g.text.Draw(screen, strconv.Itoa(g.lines), g.nextPieceRect.Min.X+g.nextPieceRect.Dx()-5, g.nextPieceRect.Min.Y+g.nextPieceRect.Dy()+190+7)
 
-	if g.gameOver {
-		screen.DrawImage(g.gameOverImage, nil)
-	}
 }
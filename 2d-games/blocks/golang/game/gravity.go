@@ -0,0 +1,156 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// gravity tables are written for 60 frames per second and scaled to ebiten.TPS at runtime
+	gravityFrameRate = 60
+	maxGravityRows   = 20
+)
+
+// Gravity is the falling speed of a piece: Rows rows every Frames frames.
+type Gravity struct {
+	Rows   int
+	Frames int
+}
+
+func FramesPerRow(frames int) Gravity {
+	return Gravity{Rows: 1, Frames: frames}
+}
+
+func RowsPerFrame(rows int) Gravity {
+	return Gravity{Rows: rows, Frames: 1}
+}
+
+// InternalGravity is the arcade notation where 256 means one row per frame
+// and 5120 means 20G.
+func InternalGravity(g int) Gravity {
+	return Gravity{Rows: g, Frames: 256}
+}
+
+func (g Gravity) rate(tps int) (steps, ticks int) {
+	if g.Rows > maxGravityRows*g.Frames {
+		g = RowsPerFrame(maxGravityRows)
+	}
+
+	return g.Rows * gravityFrameRate, g.Frames * tps
+}
+
+type LevelSpec struct {
+	Gravity Gravity
+	// Lines is the number of lines to clear on this level to advance, 0 means the level is the last one
+	Lines int
+	// LockDelay is the number of frames a grounded piece waits before locking, 0 locks on the first failed gravity step
+	LockDelay int
+}
+
+type GravityCurve struct {
+	Name   string
+	Levels []LevelSpec
+}
+
+func (c *GravityCurve) Level(level int) LevelSpec {
+	if level < 0 {
+		level = 0
+	}
+
+	if level >= len(c.Levels) {
+		level = len(c.Levels) - 1
+	}
+
+	return c.Levels[level]
+}
+
+func (c *GravityCurve) MaxLevel() int {
+	return len(c.Levels) - 1
+}
+
+func (c *GravityCurve) LevelForLines(lines int) int {
+	level := 0
+	for level < c.MaxLevel() {
+		l := c.Levels[level].Lines
+		if l <= 0 || lines < l {
+			break
+		}
+
+		lines -= l
+		level++
+	}
+
+	return level
+}
+
+const (
+	GravityCurveNES       = "nes"
+	GravityCurveGuideline = "guideline"
+	GravityCurveMaster    = "master"
+)
+
+var gravityCurves = map[string]*GravityCurve{
+	GravityCurveNES:       newNESGravityCurve(),
+	GravityCurveGuideline: newGuidelineGravityCurve(),
+	GravityCurveMaster:    newMasterGravityCurve(),
+}
+
+func GetGravityCurve(name string) (*GravityCurve, error) {
+	c, ok := gravityCurves[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown gravity curve '%s', available curves: %v", name, GravityCurveNames())
+	}
+
+	return c, nil
+}
+
+func GravityCurveNames() []string {
+	names := make([]string, 0, len(gravityCurves))
+	for n := range gravityCurves {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func newNESGravityCurve() *GravityCurve {
+	frames := []int{48, 43, 38, 33, 28, 23, 18, 13, 8, 6, 5, 5, 5, 4, 4, 4, 3, 3, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1}
+	levels := make([]LevelSpec, len(frames))
+	for i, f := range frames {
+		levels[i] = LevelSpec{Gravity: FramesPerRow(f), Lines: 10}
+	}
+
+	levels[len(levels)-1].Lines = 0
+
+	return &GravityCurve{Name: GravityCurveNES, Levels: levels}
+}
+
+// newGuidelineGravityCurve uses the guideline formula (0.8 - (level - 1) * 0.007) ^ (level - 1) seconds per row
+func newGuidelineGravityCurve() *GravityCurve {
+	levels := make([]LevelSpec, 20)
+	for i := range levels {
+		seconds := math.Pow(0.8-float64(i)*0.007, float64(i))
+		frames := int(math.Round(seconds * gravityFrameRate * 256))
+
+		levels[i] = LevelSpec{Gravity: Gravity{Rows: 256, Frames: max(frames, 1)}, Lines: 10, LockDelay: 30}
+	}
+
+	levels[len(levels)-1].Lines = 0
+
+	return &GravityCurve{Name: GravityCurveGuideline, Levels: levels}
+}
+
+func newMasterGravityCurve() *GravityCurve {
+	internal := []int{4, 6, 8, 10, 12, 16, 32, 48, 64, 80, 96, 112, 128, 144, 160, 192, 256, 512, 1280, 5120}
+	levels := make([]LevelSpec, len(internal))
+	for i, g := range internal {
+		levels[i] = LevelSpec{Gravity: InternalGravity(g), Lines: 10, LockDelay: 30}
+	}
+
+	levels[len(levels)-1].Lines = 0
+
+	return &GravityCurve{Name: GravityCurveMaster, Levels: levels}
+}
@@ -0,0 +1,87 @@
+package game_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/blocks/game"
+)
+
+func TestTicksCounterAdvance(t *testing.T) {
+	testCases := []struct {
+		steps    int
+		ticks    int
+		expected []int
+	}{
+		{1, 3, []int{0, 0, 1, 0, 0, 1}},
+		{1, 1, []int{1, 1, 1}},
+		{3, 2, []int{1, 2, 1, 2}},
+		{20, 1, []int{20, 20}},
+		{4, 256, append(make([]int, 63), 1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d steps per %d ticks", tc.steps, tc.ticks), func(t *testing.T) {
+			c := game.NewTicksCounter(1)
+			c.SetRate(tc.steps, tc.ticks)
+
+			for i, e := range tc.expected {
+				if actual := c.Advance(); actual != e {
+					t.Fatalf("tick %d expected=%d actual=%d", i, e, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestGravityCurveLevelForLines(t *testing.T) {
+	testCases := []struct {
+		curve    string
+		lines    int
+		expected int
+	}{
+		{game.GravityCurveNES, 0, 0},
+		{game.GravityCurveNES, 9, 0},
+		{game.GravityCurveNES, 10, 1},
+		{game.GravityCurveNES, 295, 29},
+		{game.GravityCurveNES, 1000, 29},
+		{game.GravityCurveGuideline, 25, 2},
+		{game.GravityCurveGuideline, 1000, 19},
+		{game.GravityCurveMaster, 1000, 19},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %d lines", tc.curve, tc.lines), func(t *testing.T) {
+			c, err := game.GetGravityCurve(tc.curve)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual := c.LevelForLines(tc.lines); actual != tc.expected {
+				t.Errorf("expected=%d actual=%d", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestGravityCurvesAreMonotonic(t *testing.T) {
+	for _, name := range game.GravityCurveNames() {
+		c, err := game.GetGravityCurve(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for l := 1; l <= c.MaxLevel(); l++ {
+			prev, cur := c.Level(l-1).Gravity, c.Level(l).Gravity
+			if cur.Rows*prev.Frames < prev.Rows*cur.Frames {
+				t.Errorf("%s level %d is slower than level %d", name, l, l-1)
+			}
+		}
+	}
+}
+
+func TestGetGravityCurveUnknown(t *testing.T) {
+	if _, err := game.GetGravityCurve("unknown"); err == nil {
+		t.Error("unknown gravity curve should return an error")
+	}
+}
@@ -15,17 +15,23 @@ const (
 )
 
 func GetKeyPressed() KeyCode {
+	key, _ := GetKeyPress()
+	return key
+}
+
+// GetKeyPress returns the pressed key and whether it is an auto repeat of a held key
+func GetKeyPress() (KeyCode, bool) {
 	if inpututil.IsKeyJustPressed(ebiten.KeySpace) || inpututil.IsKeyJustPressed(ebiten.KeyUp) {
-		return KeyRotate
+		return KeyRotate, false
 	} else if d := inpututil.KeyPressDuration(ebiten.KeyArrowLeft); isKeyPressDurationValid(d) {
-		return KeyLeft
+		return KeyLeft, d > 1
 	} else if d := inpututil.KeyPressDuration(ebiten.KeyArrowRight); isKeyPressDurationValid(d) {
-		return KeyRight
+		return KeyRight, d > 1
 	} else if d := inpututil.KeyPressDuration(ebiten.KeyArrowDown); isKeyPressDurationValid(d) {
-		return KeyDown
+		return KeyDown, d > 1
 	}
 
-	return -1
+	return -1, false
 }
 
 func isKeyPressDurationValid(d int) bool {
@@ -0,0 +1,7 @@
+//go:build !windows
+
+package game
+
+func systemLanguage() string {
+	return ""
+}
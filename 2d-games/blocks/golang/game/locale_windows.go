@@ -0,0 +1,12 @@
+package game
+
+import "golang.org/x/sys/windows"
+
+func systemLanguage() string {
+	languages, err := windows.GetUserPreferredUILanguages(windows.MUI_LANGUAGE_NAME)
+	if err != nil || len(languages) == 0 {
+		return ""
+	}
+
+	return languages[0]
+}
@@ -0,0 +1,180 @@
+package game
+
+import (
+	"os"
+	"strings"
+)
+
+type MessageKey int
+
+const (
+	MessageNext MessageKey = iota
+	MessageScore
+	MessageLevel
+	MessageLines
+	MessageGameOver
+	MessagePalette
+	MessageQueue
+	MessageGravity
+	MessageOn
+	MessageOff
+	MessageUndoSteps
+	MessageSandboxHelp
+	MessageSaved
+	MessageLoaded
+	MessageBlocked
+	MessageActionRotate
+	MessageActionLeft
+	MessageActionRight
+	MessageActionDASLeft
+	MessageActionDASRight
+	MessageFinesseShortest
+	MessageFinesseInputs
+	MessageFinesseFault
+	MessageFinesseWrongSpot
+	MessageFinesseRetry
+	MessageFinesseProgress
+	MessageFinesseResult
+)
+
+const (
+	LanguageEnglish = "en"
+	LanguageTurkish = "tr"
+)
+
+var messageCatalogs = map[string]map[MessageKey]string{
+	LanguageEnglish: {
+		MessageNext:      "NEXT",
+		MessageScore:     "SCORE",
+		MessageLevel:     "LEVEL",
+		MessageLines:     "LINES",
+		MessageGameOver:  "GAME OVER\nPRESS ANY KEY TO RESTART",
+		MessagePalette:   "PALETTE",
+		MessageQueue:     "QUEUE",
+		MessageGravity:   "GRAVITY",
+		MessageOn:        "ON",
+		MessageOff:       "OFF",
+		MessageUndoSteps: "UNDO STEPS",
+		MessageSandboxHelp: strings.Join([]string{
+			"LEFT CLICK: PAINT / QUEUE",
+			"RIGHT CLICK: ERASE / BRUSH",
+			"ENTER: DROP PIECE",
+			"G: TOGGLE GRAVITY",
+			"BACKSPACE: UNDO",
+			"DELETE: CLEAR BOARD",
+			"Q: CLEAR QUEUE",
+			"F5: SAVE  F9: LOAD",
+		}, "\n"),
+		MessageSaved:            "SAVED",
+		MessageLoaded:           "LOADED",
+		MessageBlocked:          "BLOCKED, UNDO OR CLEAR",
+		MessageActionRotate:     "ROTATE",
+		MessageActionLeft:       "LEFT",
+		MessageActionRight:      "RIGHT",
+		MessageActionDASLeft:    "DAS LEFT",
+		MessageActionDASRight:   "DAS RIGHT",
+		MessageFinesseShortest:  "SHORTEST",
+		MessageFinesseInputs:    "INPUTS",
+		MessageFinesseFault:     "FINESSE FAULT",
+		MessageFinesseWrongSpot: "WRONG SPOT",
+		MessageFinesseRetry:     "TRY AGAIN",
+		MessageFinesseProgress:  "PIECE %d/%d\nFINESSE %d%%",
+		MessageFinesseResult:    "FINESSE %d%%\n%d/%d CLEAN PLACEMENTS\nPRESS ENTER TO RESTART",
+	},
+	LanguageTurkish: {
+		MessageNext:      "SIRADAKİ",
+		MessageScore:     "PUAN",
+		MessageLevel:     "SEVİYE",
+		MessageLines:     "SATIR",
+		MessageGameOver:  "OYUN BİTTİ\nYENİDEN BAŞLAMAK İÇİN\nBİR TUŞA BASIN",
+		MessagePalette:   "PALET",
+		MessageQueue:     "SIRA",
+		MessageGravity:   "YERÇEKİMİ",
+		MessageOn:        "AÇIK",
+		MessageOff:       "KAPALI",
+		MessageUndoSteps: "GERİ ALMA ADIMI",
+		MessageSandboxHelp: strings.Join([]string{
+			"SOL TIK: BOYA / SIRAYA EKLE",
+			"SAĞ TIK: SİL / FIRÇA SEÇ",
+			"ENTER: PARÇAYI BIRAK",
+			"G: YERÇEKİMİ AÇ/KAPA",
+			"BACKSPACE: GERİ AL",
+			"DELETE: TAHTAYI TEMİZLE",
+			"Q: SIRAYI TEMİZLE",
+			"F5: KAYDET  F9: YÜKLE",
+		}, "\n"),
+		MessageSaved:            "KAYDEDİLDİ",
+		MessageLoaded:           "YÜKLENDİ",
+		MessageBlocked:          "TIKANDI: GERİ AL / TEMİZLE",
+		MessageActionRotate:     "ÇEVİR",
+		MessageActionLeft:       "SOL",
+		MessageActionRight:      "SAĞ",
+		MessageActionDASLeft:    "DAS SOL",
+		MessageActionDASRight:   "DAS SAĞ",
+		MessageFinesseShortest:  "EN KISA",
+		MessageFinesseInputs:    "GİRİŞLER",
+		MessageFinesseFault:     "FAZLA HAREKET",
+		MessageFinesseWrongSpot: "YANLIŞ YER",
+		MessageFinesseRetry:     "TEKRAR DENE",
+		MessageFinesseProgress:  "PARÇA %d/%d\nHASSASİYET %%%d",
+		MessageFinesseResult:    "HASSASİYET %%%d\n%d/%d TEMİZ YERLEŞTİRME\nYENİDEN BAŞLAMAK İÇİN\nENTER'A BASIN",
+	},
+}
+
+type Localizer struct {
+	language string
+	messages map[MessageKey]string
+}
+
+// NewLocalizer returns the localizer of the given language, an empty language is detected from the OS locale.
+// Unknown languages fall back to English.
+func NewLocalizer(language string) *Localizer {
+	if language == "" {
+		language = DetectLanguage()
+	}
+
+	language = normalizeLanguage(language)
+	messages, ok := messageCatalogs[language]
+	if !ok {
+		language = LanguageEnglish
+		messages = messageCatalogs[language]
+	}
+
+	return &Localizer{
+		language: language,
+		messages: messages,
+	}
+}
+
+func (l *Localizer) Language() string {
+	return l.language
+}
+
+func (l *Localizer) Get(key MessageKey) string {
+	if m, ok := l.messages[key]; ok {
+		return m
+	}
+
+	return messageCatalogs[LanguageEnglish][key]
+}
+
+// DetectLanguage returns the language of the OS locale
+func DetectLanguage() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG", "LANGUAGE"} {
+		if v := os.Getenv(name); v != "" && v != "C" && v != "POSIX" {
+			return normalizeLanguage(v)
+		}
+	}
+
+	return normalizeLanguage(systemLanguage())
+}
+
+// normalizeLanguage turns locale names like tr_TR.UTF-8 or tr-TR into tr
+func normalizeLanguage(locale string) string {
+	l := strings.ToLower(locale)
+	if i := strings.IndexAny(l, "_-.:@"); i >= 0 {
+		l = l[:i]
+	}
+
+	return l
+}
@@ -0,0 +1,61 @@
+package game
+
+import (
+	"testing"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestMessageCatalogsAreComplete(t *testing.T) {
+	english := messageCatalogs[LanguageEnglish]
+	for language, messages := range messageCatalogs {
+		for key := range english {
+			if messages[key] == "" {
+				t.Errorf("%s: message %d is missing", language, key)
+			}
+		}
+	}
+}
+
+func TestMessagesCanBeRenderedWithEmbeddedFonts(t *testing.T) {
+	var buf sfnt.Buffer
+	for _, fontName := range []string{RobotoRegularFontName, RobotoBoldFontName} {
+		f := fontLibrary.GetFont(fontName)
+		for language, messages := range messageCatalogs {
+			for key, m := range messages {
+				for _, r := range m {
+					if r == '\n' {
+						continue
+					}
+
+					if i, err := f.GlyphIndex(&buf, r); err != nil || i == 0 {
+						t.Errorf("%s: %s message %d has no glyph for '%c'", fontName, language, key, r)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNewLocalizer(t *testing.T) {
+	testCases := []struct {
+		language string
+		expected string
+	}{
+		{"tr", LanguageTurkish},
+		{"tr_TR.UTF-8", LanguageTurkish},
+		{"tr-TR", LanguageTurkish},
+		{"en_US", LanguageEnglish},
+		{"de_DE", LanguageEnglish},
+	}
+
+	for _, tc := range testCases {
+		if actual := NewLocalizer(tc.language).Language(); actual != tc.expected {
+			t.Errorf("NewLocalizer(%q) expected=%s actual=%s", tc.language, tc.expected, actual)
+		}
+	}
+
+	if actual := NewLocalizer("tr").Get(MessageLevel); actual != "SEVİYE" {
+		t.Errorf("expected SEVİYE, actual %s", actual)
+	}
+}
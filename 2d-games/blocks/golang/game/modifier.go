@@ -0,0 +1,208 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Modifier changes the rules of a game variant. Modifiers can be combined with each other and with any mode,
+// the game scene calls the hooks below instead of checking for the variants itself.
+type Modifier interface {
+	// PieceSpawned is called when a new current piece is created, before it is checked for collisions
+	PieceSpawned(g *GameScene, piece *Piece)
+	// Input can replace the key pressed in this tick
+	Input(g *GameScene, key KeyCode) KeyCode
+	// Update is called once per tick before gravity is applied
+	Update(g *GameScene)
+	// CellColor can change the colour a locked cell is drawn with
+	CellColor(g *GameScene, cell Cell, clr color.Color) color.Color
+}
+
+// baseModifier implements every hook as a no-op so modifiers only implement the hooks they need
+type baseModifier struct{}
+
+func (baseModifier) PieceSpawned(g *GameScene, piece *Piece) {}
+
+func (baseModifier) Input(g *GameScene, key KeyCode) KeyCode {
+	return key
+}
+
+func (baseModifier) Update(g *GameScene) {}
+
+func (baseModifier) CellColor(g *GameScene, cell Cell, clr color.Color) color.Color {
+	return clr
+}
+
+const (
+	ModifierInvisible   = "invisible"
+	ModifierBig         = "big"
+	ModifierMirror      = "mirror"
+	ModifierRisingFloor = "rising"
+)
+
+var modifierFactories = map[string]struct {
+	defaultValue int
+	create       func(value int) Modifier
+}{
+	ModifierInvisible:   {2, func(seconds int) Modifier { return newInvisibleModifier(seconds * ebiten.TPS()) }},
+	ModifierBig:         {2, func(scale int) Modifier { return &bigModifier{scale: scale} }},
+	ModifierMirror:      {10, func(pieces int) Modifier { return &mirrorModifier{pieces: pieces} }},
+	ModifierRisingFloor: {10, func(seconds int) Modifier { return newRisingFloorModifier(seconds * ebiten.TPS()) }},
+}
+
+// ParseModifiers parses a comma separated list of modifier names with an optional value, e.g. "invisible:3,mirror:5,big".
+// The value is the fade time in seconds for invisible, the scale for big, the number of pieces between swaps for mirror
+// and the seconds between garbage rows for rising.
+func ParseModifiers(spec string) ([]Modifier, error) {
+	var result []Modifier
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(s, ":")
+		f, ok := modifierFactories[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown modifier '%s', available modifiers: %s", name, strings.Join(ModifierNames(), ", "))
+		}
+
+		v := f.defaultValue
+		if hasValue {
+			var err error
+			v, err = strconv.Atoi(value)
+			if err != nil || v <= 0 {
+				return nil, fmt.Errorf("invalid value '%s' for modifier '%s'", value, name)
+			}
+		}
+
+		result = append(result, f.create(v))
+	}
+
+	return result, nil
+}
+
+func ModifierNames() []string {
+	names := make([]string, 0, len(modifierFactories))
+	for n := range modifierFactories {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// invisibleModifier fades locked cells out until only the empty playfield is visible
+type invisibleModifier struct {
+	baseModifier
+	fadeTicks int
+}
+
+func newInvisibleModifier(fadeTicks int) *invisibleModifier {
+	return &invisibleModifier{fadeTicks: max(fadeTicks, 1)}
+}
+
+func (m *invisibleModifier) Update(g *GameScene) {
+	p := g.playField
+	for y := range p.cells {
+		for _, c := range p.cells[y] {
+			if c.Filled && g.ticks-c.LockedAt <= m.fadeTicks {
+				p.invalidate()
+				return
+			}
+		}
+	}
+}
+
+func (m *invisibleModifier) CellColor(g *GameScene, cell Cell, clr color.Color) color.Color {
+	if cell.IsEmpty() {
+		return clr
+	}
+
+	t := float64(g.ticks-cell.LockedAt) / float64(m.fadeTicks)
+
+	return lerpColor(clr, g.playField.theme.Empty, min(max(t, 0), 1))
+}
+
+// bigModifier makes every block of a piece scale x scale cells
+type bigModifier struct {
+	baseModifier
+	scale int
+}
+
+func (m *bigModifier) PieceSpawned(g *GameScene, piece *Piece) {
+	piece.scale(m.scale)
+}
+
+// mirrorModifier swaps left and right every given number of pieces
+type mirrorModifier struct {
+	baseModifier
+	pieces   int
+	spawned  int
+	mirrored bool
+}
+
+func (m *mirrorModifier) PieceSpawned(g *GameScene, piece *Piece) {
+	if m.spawned > 0 && m.spawned%m.pieces == 0 {
+		m.mirrored = !m.mirrored
+	}
+
+	m.spawned++
+}
+
+func (m *mirrorModifier) Input(g *GameScene, key KeyCode) KeyCode {
+	if !m.mirrored {
+		return key
+	}
+
+	switch key {
+	case KeyLeft:
+		return KeyRight
+	case KeyRight:
+		return KeyLeft
+	}
+
+	return key
+}
+
+// risingFloorModifier pushes a garbage row with a single hole into the bottom of the playfield periodically
+type risingFloorModifier struct {
+	baseModifier
+	counter *TicksCounter
+}
+
+func newRisingFloorModifier(ticks int) *risingFloorModifier {
+	return &risingFloorModifier{counter: NewTicksCounter(max(ticks, 1))}
+}
+
+func (m *risingFloorModifier) Update(g *GameScene) {
+	if !m.counter.Update() {
+		return
+	}
+
+	if !g.playField.InsertGarbageRow(rand.Intn(g.playField.width), g.ticks) {
+		g.gameOver = true
+		return
+	}
+
+	if g.currentPiece.collides() {
+		g.currentPiece.translate(0, -1)
+	}
+}
+
+func lerpColor(from, to color.Color, t float64) color.Color {
+	r1, g1, b1, a1 := from.RGBA()
+	r2, g2, b2, a2 := to.RGBA()
+	lerp := func(a, b uint32) uint16 {
+		return uint16(float64(a) + (float64(b)-float64(a))*t)
+	}
+
+	return color.RGBA64{R: lerp(r1, r2), G: lerp(g1, g2), B: lerp(b1, b2), A: lerp(a1, a2)}
+}
@@ -0,0 +1,104 @@
+package game
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseModifiers(t *testing.T) {
+	modifiers, err := ParseModifiers("invisible:3, big ,mirror:5,rising")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(modifiers) != 4 {
+		t.Fatalf("expected 4 modifiers, actual %d", len(modifiers))
+	}
+
+	if m, ok := modifiers[2].(*mirrorModifier); !ok || m.pieces != 5 {
+		t.Errorf("expected mirror modifier with 5 pieces, actual %#v", modifiers[2])
+	}
+
+	if m, ok := modifiers[1].(*bigModifier); !ok || m.scale != 2 {
+		t.Errorf("expected big modifier with scale 2, actual %#v", modifiers[1])
+	}
+
+	for _, spec := range []string{"unknown", "mirror:0", "big:x"} {
+		if _, err := ParseModifiers(spec); err == nil {
+			t.Errorf("ParseModifiers(%q) expected an error", spec)
+		}
+	}
+
+	_, err = ParseModifiers("unknown")
+	if expected := strings.Join(ModifierNames(), ", "); err == nil || !strings.HasSuffix(err.Error(), expected) {
+		t.Errorf("expected the error to list the modifiers %q, actual %v", expected, err)
+	}
+}
+
+func TestModifierNames(t *testing.T) {
+	expected := []string{ModifierBig, ModifierInvisible, ModifierMirror, ModifierRisingFloor}
+	if actual := ModifierNames(); !slices.Equal(actual, expected) {
+		t.Errorf("expected=%v actual=%v", expected, actual)
+	}
+}
+
+func TestMirrorModifierSwapsEveryNPieces(t *testing.T) {
+	m := &mirrorModifier{pieces: 2}
+	expected := []KeyCode{KeyLeft, KeyLeft, KeyRight, KeyRight, KeyLeft}
+
+	for i, e := range expected {
+		m.PieceSpawned(nil, nil)
+		if actual := m.Input(nil, KeyLeft); actual != e {
+			t.Errorf("piece %d expected=%v actual=%v", i, e, actual)
+		}
+	}
+}
+
+func TestBigModifierScalesPiece(t *testing.T) {
+	p := newPlayField(0, 0, 10, 20, 0, 1)
+	piece := createPiece(p, PieceTypeT)
+	(&bigModifier{scale: 2}).PieceSpawned(nil, piece)
+
+	if len(*piece.blocks) != 16 {
+		t.Fatalf("expected 16 blocks, actual %d", len(*piece.blocks))
+	}
+
+	size := func() (int, int) {
+		minX, minY, maxX, maxY := 100, 100, -100, -100
+		for _, b := range *piece.blocks {
+			minX, minY, maxX, maxY = min(minX, b.X), min(minY, b.Y), max(maxX, b.X), max(maxY, b.Y)
+		}
+
+		return maxX - minX + 1, maxY - minY + 1
+	}
+
+	if w, h := size(); w != 6 || h != 4 {
+		t.Errorf("expected 6x4 piece, actual %dx%d", w, h)
+	}
+
+	piece.Turn()
+	if w, h := size(); w != 4 || h != 6 {
+		t.Errorf("expected 4x6 piece after turn, actual %dx%d", w, h)
+	}
+}
+
+func TestInsertGarbageRow(t *testing.T) {
+	p := newTestPlayField(
+		"....",
+		"..#.",
+	)
+
+	if !p.InsertGarbageRow(1, 0) {
+		t.Fatal("expected no overflow")
+	}
+
+	expected := "..#.\n#.##\n"
+	if actual := p.String(); actual != expected {
+		t.Errorf("\nExpected:\n%s\nActual:\n%s", expected, actual)
+	}
+
+	if p.InsertGarbageRow(0, 0) {
+		t.Error("expected overflow")
+	}
+}
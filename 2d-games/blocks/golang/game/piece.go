@@ -25,11 +25,37 @@ const (
 	PieceTypeDot
 )
 
+var pieceTypeSymbols = map[PieceType]rune{
+	PieceTypeI:   'I',
+	PieceTypeJ:   'J',
+	PieceTypeL:   'L',
+	PieceTypeO:   'O',
+	PieceTypeS:   'S',
+	PieceTypeT:   'T',
+	PieceTypeZ:   'Z',
+	PieceTypeII:  '2',
+	PieceTypeIII: '3',
+	PieceTypeDot: '1',
+}
+
+func (t PieceType) Symbol() rune {
+	return pieceTypeSymbols[t]
+}
+
+func ParsePieceType(r rune) (PieceType, bool) {
+	for t, s := range pieceTypeSymbols {
+		if s == r {
+			return t, true
+		}
+	}
+
+	return 0, false
+}
+
 type pieceDefinition struct {
 	blocks         []Point
 	pivotIndex     int
 	rotationAngles []int
-	color          color.Color
 }
 
 var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefinition{
@@ -42,7 +68,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     1,
 		rotationAngles: []int{90, -90},
-		color:          color.RGBA{R: 175, G: 238, B: 238, A: 255},
 	},
 	PieceTypeJ: pieceDefinition{
 		blocks: []Point{
@@ -53,7 +78,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     2,
 		rotationAngles: []int{90},
-		color:          color.RGBA{R: 137, G: 207, B: 240, A: 255},
 	},
 	PieceTypeL: pieceDefinition{
 		blocks: []Point{
@@ -64,7 +88,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     1,
 		rotationAngles: []int{90},
-		color:          color.RGBA{R: 255, G: 179, B: 102, A: 255},
 	},
 	PieceTypeO: pieceDefinition{
 		blocks: []Point{
@@ -75,7 +98,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     -1,
 		rotationAngles: []int{90},
-		color:          color.RGBA{R: 253, G: 253, B: 150, A: 255},
 	},
 	PieceTypeS: pieceDefinition{
 		blocks: []Point{
@@ -86,7 +108,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     1,
 		rotationAngles: []int{90, -90},
-		color:          color.RGBA{R: 119, G: 221, B: 119, A: 255},
 	},
 	PieceTypeT: pieceDefinition{
 		blocks: []Point{
@@ -97,7 +118,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     1,
 		rotationAngles: []int{90},
-		color:          color.RGBA{R: 216, G: 191, B: 216, A: 255},
 	},
 	PieceTypeZ: pieceDefinition{
 		blocks: []Point{
@@ -108,7 +128,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     2,
 		rotationAngles: []int{90, -90},
-		color:          color.RGBA{R: 255, G: 153, B: 153, A: 255},
 	},
 	PieceTypeII: pieceDefinition{
 		blocks: []Point{
@@ -117,7 +136,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     0,
 		rotationAngles: []int{90, -90},
-		color:          color.RGBA{R: 200, G: 200, B: 200, A: 255},
 	},
 	PieceTypeIII: pieceDefinition{
 		blocks: []Point{
@@ -127,7 +145,6 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     1,
 		rotationAngles: []int{90, -90},
-		color:          color.RGBA{R: 181, G: 101, B: 29, A: 255},
 	},
 	PieceTypeDot: pieceDefinition{
 		blocks: []Point{
@@ -135,15 +152,14 @@ var pieceDefinitions map[PieceType]pieceDefinition = map[PieceType]pieceDefiniti
 		},
 		pivotIndex:     -1,
 		rotationAngles: []int{90},
-		color:          color.RGBA{R: 191, G: 255, B: 164, A: 255},
 	},
 }
 
 type Piece struct {
 	playField      *PlayField
+	pieceType      PieceType
 	blocks         *[]*Point
 	pivotIndex     int
-	color          color.Color
 	rotationAngles []int
 	rotationIndex  int
 }
@@ -190,20 +206,42 @@ func (piece *Piece) MoveDown() bool {
 	return true
 }
 
-func (piece *Piece) AbsorbIntoPlayField() {
+func (piece *Piece) IsGrounded() bool {
+	piece.translate(0, 1)
+	grounded := piece.collides()
+	piece.translate(0, -1)
+
+	return grounded
+}
+
+func (piece *Piece) AbsorbIntoPlayField(lockedAt int) {
 	for _, p := range *piece.blocks {
-		piece.playField.SetBlock(p.X, p.Y, piece.color)
+		if piece.playField.Contains(p.X, p.Y) {
+			piece.playField.SetCell(p.X, p.Y, newPieceCell(piece.pieceType, lockedAt))
+		}
 	}
 }
 
 func (piece *Piece) Draw(screen *ebiten.Image) {
+	c := piece.Color()
 	for _, p := range *piece.blocks {
-		piece.playField.FillBlock(screen, float32(p.X), float32(p.Y), piece.color)
+		piece.playField.FillBlock(screen, float32(p.X), float32(p.Y), c)
 	}
 }
 
+func (piece *Piece) Color() color.Color {
+	return piece.playField.theme.PieceColor(piece.pieceType)
+}
+
 func createNewPiece(playField *PlayField) *Piece {
-	t := PieceType(rand.Intn(len(pieceDefinitions)))
+	return createPiece(playField, randomPieceType())
+}
+
+func randomPieceType() PieceType {
+	return PieceType(rand.Intn(len(pieceDefinitions)))
+}
+
+func createPiece(playField *PlayField, t PieceType) *Piece {
 	d := pieceDefinitions[t]
 	blocks := make([]*Point, len(d.blocks))
 
@@ -213,8 +251,8 @@ func createNewPiece(playField *PlayField) *Piece {
 
 	p := Piece{
 		blocks:         &blocks,
+		pieceType:      t,
 		pivotIndex:     d.pivotIndex,
-		color:          d.color,
 		playField:      playField,
 		rotationAngles: d.rotationAngles,
 		rotationIndex:  0,
@@ -223,6 +261,24 @@ func createNewPiece(playField *PlayField) *Piece {
 	return &p
 }
 
+// scale replaces every block with n x n blocks, the pivot becomes the top left block of the scaled pivot
+func (piece *Piece) scale(n int) {
+	blocks := make([]*Point, 0, len(*piece.blocks)*n*n)
+	for _, b := range *piece.blocks {
+		for dy := 0; dy < n; dy++ {
+			for dx := 0; dx < n; dx++ {
+				blocks = append(blocks, &Point{X: b.X*n + dx, Y: b.Y*n + dy})
+			}
+		}
+	}
+
+	if piece.pivotIndex >= 0 {
+		piece.pivotIndex *= n * n
+	}
+
+	piece.blocks = &blocks
+}
+
 func (piece *Piece) getRectangle() image.Rectangle {
 	minX, minY, maxX, maxY := 0, 0, 0, 0
 
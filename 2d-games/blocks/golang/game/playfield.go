@@ -1,31 +1,35 @@
 package game
 
 import (
+	"fmt"
 	"image/color"
+	"sort"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
 type PlayField struct {
-	x          int
-	y          int
-	width      int
-	height     int
-	tileSize   int
-	blocks     [][]color.Color
-	emptyColor color.Color
-}
-
-func newPlayField(x, y, width, height, tileSize int) *PlayField {
-	emptyColor := color.RGBA{0, 0, 0, 220}
-	blocks := make([][]color.Color, height)
-	for i := 0; i < height; i++ {
-		blocks[i] = make([]color.Color, width)
-
-		for j := range blocks[i] {
-			blocks[i][j] = emptyColor
-		}
+	x      int
+	y      int
+	width  int
+	height int
+	// hidden is the number of rows above the visible playfield, they are stored at the top of cells
+	hidden   int
+	tileSize int
+	cells    [][]Cell
+	theme    *Theme
+	// cellColor returns the colour a locked cell is drawn with
+	cellColor func(c Cell) color.Color
+
+	// the locked cells are rendered to stackImage and only redrawn when a cell changes
+	stackImage *ebiten.Image
+	dirty      bool
+}
+
+func newPlayField(x, y, width, height, hidden, tileSize int) *PlayField {
+	cells := make([][]Cell, hidden+height)
+	for i := range cells {
+		cells[i] = make([]Cell, width)
 	}
 
 	return &PlayField{
@@ -33,9 +37,13 @@ func newPlayField(x, y, width, height, tileSize int) *PlayField {
 		y:          y,
 		width:      width,
 		height:     height,
+		hidden:     hidden,
 		tileSize:   tileSize,
-		blocks:     blocks,
-		emptyColor: emptyColor,
+		cells:      cells,
+		theme:      defaultTheme,
+		cellColor:  defaultTheme.CellColor,
+		stackImage: ebiten.NewImage(width*tileSize, height*tileSize),
+		dirty:      true,
 	}
 }
 
@@ -44,15 +52,71 @@ func (p *PlayField) GetSize() (int, int) {
 }
 
 func (p *PlayField) Draw(screen *ebiten.Image) {
-	for i := range p.blocks {
-		for j := range p.blocks[i] {
-			p.FillBlock(screen, float32(j), float32(i), p.blocks[i][j])
+	if p.dirty {
+		p.renderStack()
+		p.dirty = false
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64(p.x), float64(p.y))
+	drawImage(screen, p.stackImage, op)
+}
+
+func (p *PlayField) renderStack() {
+	size := float32(p.tileSize)
+
+	p.stackImage.Clear()
+	for i := 0; i < p.height; i++ {
+		for j, c := range p.cells[i+p.hidden] {
+			fillRect(p.stackImage, float32(j)*size, float32(i)*size, size, size, p.cellColor(c))
 		}
 	}
 }
 
-func (p *PlayField) SetBlock(x, y int, color color.Color) {
-	p.blocks[y][x] = color
+func (p *PlayField) invalidate() {
+	p.dirty = true
+}
+
+// Cell returns the cell at x, y. Rows above the visible playfield have negative y down to -hidden.
+func (p *PlayField) Cell(x, y int) Cell {
+	return p.cells[y+p.hidden][x]
+}
+
+func (p *PlayField) SetCell(x, y int, cell Cell) {
+	p.cells[y+p.hidden][x] = cell
+	p.invalidate()
+}
+
+// Contains reports whether x, y is a cell of the visible playfield or the hidden rows
+func (p *PlayField) Contains(x, y int) bool {
+	return x >= 0 && x < p.width && y >= -p.hidden && y < p.height
+}
+
+func (p *PlayField) Board() *Board {
+	b := NewBoard(p.width, p.height)
+	for y := range b.Cells {
+		copy(b.Cells[y], p.cells[y+p.hidden])
+	}
+
+	return b
+}
+
+func (p *PlayField) LoadBoard(b *Board) error {
+	if b.Width != p.width || b.Height != p.height {
+		return fmt.Errorf("board size %dx%d does not match the playfield size %dx%d", b.Width, b.Height, p.width, p.height)
+	}
+
+	for y := range p.cells[:p.hidden] {
+		clear(p.cells[y])
+	}
+
+	for y := range b.Cells {
+		copy(p.cells[y+p.hidden], b.Cells[y])
+	}
+
+	p.invalidate()
+
+	return nil
 }
 
 func (p *PlayField) FillBlock(screen *ebiten.Image, x, y float32, color color.Color) {
@@ -60,7 +124,7 @@ func (p *PlayField) FillBlock(screen *ebiten.Image, x, y float32, color color.Co
 		return
 	}
 
-	vector.DrawFilledRect(screen, float32(p.x)+x*float32(p.tileSize), float32(p.y)+y*float32(p.tileSize), float32(p.tileSize), float32(p.tileSize), color, false)
+	fillRect(screen, float32(p.x)+x*float32(p.tileSize), float32(p.y)+y*float32(p.tileSize), float32(p.tileSize), float32(p.tileSize), color)
 }
 
 func (p *PlayField) IsBlocked(x, y int) bool {
@@ -72,41 +136,184 @@ func (p *PlayField) IsBlocked(x, y int) bool {
 		return true
 	}
 
-	if y < 0 {
+	if y < -p.hidden {
 		return false
 	}
 
-	return p.blocks[y][x] != p.emptyColor
+	return p.cells[y+p.hidden][x].Filled
 }
 
 func (p *PlayField) ClearLines() int {
 	l := 0
-	for r := 0; r < len(p.blocks); r++ {
-		clearLine := true
-		for _, b := range p.blocks[r] {
-			if b == p.emptyColor {
-				clearLine = false
-				break
+	for r := 0; r < len(p.cells); r++ {
+		if !p.isLineFull(r) {
+			continue
+		}
+
+		l++
+		for n := r; n > 0; n-- {
+			copy(p.cells[n], p.cells[n-1])
+		}
+
+		clear(p.cells[0])
+	}
+
+	if l > 0 {
+		p.invalidate()
+	}
+
+	return l
+}
+
+// InsertGarbageRow pushes the stack up by one row and fills the bottom row with garbage except the hole column.
+// It returns false when blocks are pushed out of the top of the playfield.
+func (p *PlayField) InsertGarbageRow(hole int, lockedAt int) bool {
+	overflow := false
+	for _, c := range p.cells[0] {
+		if c.Filled {
+			overflow = true
+			break
+		}
+	}
+
+	for n := 0; n < len(p.cells)-1; n++ {
+		copy(p.cells[n], p.cells[n+1])
+	}
+
+	bottom := p.cells[len(p.cells)-1]
+	for x := range bottom {
+		if x == hole {
+			bottom[x] = Cell{}
+		} else {
+			bottom[x] = newGarbageCell(lockedAt)
+		}
+	}
+
+	p.invalidate()
+
+	return !overflow
+}
+
+func (p *PlayField) isLineFull(r int) bool {
+	for _, c := range p.cells[r] {
+		if c.IsEmpty() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ClearLinesCascade clears full lines and lets the connected groups of blocks above fall as units.
+// Falling groups can complete new lines which are cleared as the next chain step.
+// It returns the number of lines cleared in each chain step.
+func (p *PlayField) ClearLinesCascade() []int {
+	var chains []int
+	for {
+		l := p.ClearLines()
+		if l == 0 {
+			return chains
+		}
+
+		chains = append(chains, l)
+		p.dropComponents()
+	}
+}
+
+func (p *PlayField) dropComponents() {
+	for moved := true; moved; {
+		moved = false
+
+		components := p.components()
+		sort.Slice(components, func(i, j int) bool {
+			return components[i].bottom() > components[j].bottom()
+		})
+
+		for _, c := range components {
+			if d := p.dropDistance(c); d > 0 {
+				p.moveComponent(c, d)
+				moved = true
 			}
 		}
+	}
+}
+
+type component []Point
+
+func (c component) bottom() int {
+	b := 0
+	for _, pt := range c {
+		b = max(b, pt.Y)
+	}
+
+	return b
+}
 
-		if clearLine {
-			l++
-			for c := range p.blocks[r] {
-				p.blocks[r][c] = p.emptyColor
+// components returns the groups of filled cells connected horizontally or vertically, points are indexes into cells
+func (p *PlayField) components() []component {
+	visited := make([][]bool, len(p.cells))
+	for i := range visited {
+		visited[i] = make([]bool, p.width)
+	}
+
+	var result []component
+	for y := range p.cells {
+		for x := range p.cells[y] {
+			if visited[y][x] || p.cells[y][x].IsEmpty() {
+				continue
 			}
 
-			for n := r; n >= 0; n-- {
-				for c := range p.blocks[n] {
-					if n == 0 {
-						p.blocks[n][c] = p.emptyColor
-					} else {
-						p.blocks[n][c] = p.blocks[n-1][c]
+			var c component
+			stack := []Point{{x, y}}
+			visited[y][x] = true
+			for len(stack) > 0 {
+				pt := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				c = append(c, pt)
+
+				for _, n := range []Point{{pt.X - 1, pt.Y}, {pt.X + 1, pt.Y}, {pt.X, pt.Y - 1}, {pt.X, pt.Y + 1}} {
+					if n.X < 0 || n.X >= p.width || n.Y < 0 || n.Y >= len(p.cells) || visited[n.Y][n.X] || p.cells[n.Y][n.X].IsEmpty() {
+						continue
 					}
+
+					visited[n.Y][n.X] = true
+					stack = append(stack, n)
 				}
 			}
+
+			result = append(result, c)
 		}
 	}
 
-	return l
+	return result
+}
+
+func (p *PlayField) dropDistance(c component) int {
+	owned := make(map[Point]bool, len(c))
+	for _, pt := range c {
+		owned[pt] = true
+	}
+
+	for d := 1; ; d++ {
+		for _, pt := range c {
+			below := Point{pt.X, pt.Y + d}
+			if !owned[below] && (below.Y >= len(p.cells) || p.cells[below.Y][below.X].Filled) {
+				return d - 1
+			}
+		}
+	}
+}
+
+func (p *PlayField) moveComponent(c component, d int) {
+	cells := make([]Cell, len(c))
+	for i, pt := range c {
+		cells[i] = p.cells[pt.Y][pt.X]
+		p.cells[pt.Y][pt.X] = Cell{}
+	}
+
+	for i, pt := range c {
+		p.cells[pt.Y+d][pt.X] = cells[i]
+	}
+
+	p.invalidate()
 }
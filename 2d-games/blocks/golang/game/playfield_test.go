@@ -0,0 +1,118 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func newTestPlayField(rows ...string) *PlayField {
+	p := newPlayField(0, 0, len(rows[0]), len(rows), 0, 1)
+	for y, r := range rows {
+		for x, c := range r {
+			if c != '.' {
+				p.SetCell(x, y, newPieceCell(PieceTypeO, 0))
+			}
+		}
+	}
+
+	return p
+}
+
+func (p *PlayField) String() string {
+	var sb strings.Builder
+	for y := range p.cells {
+		for _, c := range p.cells[y] {
+			if c.IsEmpty() {
+				sb.WriteRune('.')
+			} else {
+				sb.WriteRune('#')
+			}
+		}
+		sb.WriteRune('\n')
+	}
+
+	return sb.String()
+}
+
+func TestClearLinesCascade(t *testing.T) {
+	testCases := []struct {
+		rows     []string
+		expected []string
+		chains   []int
+	}{
+		{
+			rows: []string{
+				"....",
+				"##..",
+				"####",
+				"#...",
+			},
+			expected: []string{
+				"....",
+				"....",
+				"##..",
+				"#...",
+			},
+			chains: []int{1},
+		},
+		{
+			rows: []string{
+				"..##",
+				"....",
+				"####",
+				"##..",
+			},
+			expected: []string{
+				"....",
+				"....",
+				"....",
+				"....",
+			},
+			chains: []int{1, 1},
+		},
+		{
+			rows: []string{
+				".#..",
+				".#..",
+				"####",
+				"#..#",
+			},
+			expected: []string{
+				"....",
+				"....",
+				".#..",
+				"##.#",
+			},
+			chains: []int{1},
+		},
+		{
+			rows: []string{
+				"....",
+				"#.#.",
+			},
+			expected: []string{
+				"....",
+				"#.#.",
+			},
+			chains: nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
+			p := newTestPlayField(tc.rows...)
+			chains := p.ClearLinesCascade()
+
+			if !slices.Equal(chains, tc.chains) {
+				t.Errorf("chains expected=%v actual=%v", tc.chains, chains)
+			}
+
+			expected := strings.Join(tc.expected, "\n") + "\n"
+			if actual := p.String(); actual != expected {
+				t.Errorf("\nExpected:\n%s\nActual:\n%s", expected, actual)
+			}
+		})
+	}
+}
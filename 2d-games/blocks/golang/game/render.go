@@ -0,0 +1,27 @@
+package game
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// drawHook is called for every draw call issued by the game, it is nil unless a test counts the draw calls
+var drawHook func()
+
+func drawn() {
+	if drawHook != nil {
+		drawHook()
+	}
+}
+
+func fillRect(dst *ebiten.Image, x, y, width, height float32, clr color.Color) {
+	drawn()
+	vector.DrawFilledRect(dst, x, y, width, height, clr, false)
+}
+
+func drawImage(dst, src *ebiten.Image, op *ebiten.DrawImageOptions) {
+	drawn()
+	dst.DrawImage(src, op)
+}
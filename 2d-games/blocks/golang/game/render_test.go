@@ -0,0 +1,75 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// countDraws counts the draw calls issued by the game until the test ends
+func countDraws(tb testing.TB) *int {
+	n := new(int)
+	drawHook = func() { *n++ }
+	tb.Cleanup(func() { drawHook = nil })
+
+	return n
+}
+
+func TestPlayFieldDrawIsCached(t *testing.T) {
+	p := newPlayField(0, 0, 10, 20, 0, 25)
+	screen := ebiten.NewImage(p.GetSize())
+
+	draws := countDraws(t)
+	draw := func() int {
+		*draws = 0
+		p.Draw(screen)
+		return *draws
+	}
+
+	if n := draw(); n != 201 {
+		t.Errorf("first draw expected=201 actual=%d", n)
+	}
+
+	if n := draw(); n != 1 {
+		t.Errorf("unchanged draw expected=1 actual=%d", n)
+	}
+
+	p.SetCell(0, 19, newPieceCell(PieceTypeT, 0))
+	if n := draw(); n != 201 {
+		t.Errorf("draw after change expected=201 actual=%d", n)
+	}
+}
+
+func BenchmarkGameSceneDraw(b *testing.B) {
+	benchmarks := []struct {
+		name       string
+		invalidate bool
+	}{
+		{"redraw every frame", true},
+		{"cached", false},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			g, err := newGameScene(DefaultSettings())
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			screen := ebiten.NewImage(g.GetSize())
+			draws := countDraws(b)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if bm.invalidate {
+					g.playField.invalidate()
+					g.hudDirty = true
+				}
+
+				g.Draw(screen)
+			}
+
+			b.ReportMetric(float64(*draws)/float64(b.N), "draws/frame")
+		})
+	}
+}
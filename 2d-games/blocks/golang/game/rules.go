@@ -0,0 +1,120 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"sort"
+)
+
+// Rules configures where pieces spawn and when the game is topped out.
+type Rules struct {
+	// GuidelineSpawn spawns pieces centred above the visible playfield and drops them one row immediately,
+	// otherwise pieces spawn at the top left from their definition offsets
+	GuidelineSpawn bool
+	// HiddenRows is the number of rows above the visible playfield pieces can lock into, 0 or 2-20
+	HiddenRows int
+	// LockOut ends the game when a piece locks completely above the visible playfield
+	LockOut bool
+	// PartialLockOut ends the game when any block of a piece locks above the visible playfield
+	PartialLockOut bool
+	// BlockOut ends the game when a new piece overlaps the stack, otherwise the piece is pushed up until it fits
+	BlockOut bool
+}
+
+const (
+	RulesClassic   = "classic"
+	RulesGuideline = "guideline"
+
+	minHiddenRows = 2
+	maxHiddenRows = 20
+)
+
+var rulePresets = map[string]Rules{
+	RulesClassic: {
+		BlockOut: true,
+	},
+	RulesGuideline: {
+		GuidelineSpawn: true,
+		HiddenRows:     maxHiddenRows,
+		LockOut:        true,
+		BlockOut:       true,
+	},
+}
+
+func GetRules(name string) (Rules, error) {
+	r, ok := rulePresets[name]
+	if !ok {
+		return Rules{}, fmt.Errorf("unknown rules '%s', available rules: %v", name, RulesNames())
+	}
+
+	return r, nil
+}
+
+func RulesNames() []string {
+	names := make([]string, 0, len(rulePresets))
+	for name := range rulePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (r Rules) Validate() error {
+	if r.HiddenRows != 0 && (r.HiddenRows < minHiddenRows || r.HiddenRows > maxHiddenRows) {
+		return fmt.Errorf("hidden rows must be 0 or between %d and %d, got %d", minHiddenRows, maxHiddenRows, r.HiddenRows)
+	}
+
+	return nil
+}
+
+// spawn moves a new piece to its spawn position and returns false when the piece is blocked out
+func (r Rules) spawn(piece *Piece) bool {
+	if r.GuidelineSpawn {
+		b := piece.bounds()
+		piece.translate((piece.playField.width-b.Dx())/2-b.Min.X, -b.Max.Y)
+	}
+
+	if piece.collides() {
+		if r.BlockOut {
+			return false
+		}
+
+		for piece.collides() {
+			piece.translate(0, -1)
+		}
+	}
+
+	if r.GuidelineSpawn {
+		piece.MoveDown()
+	}
+
+	return true
+}
+
+// lockedOut reports whether locking the piece at its current position ends the game
+func (r Rules) lockedOut(piece *Piece) bool {
+	above := 0
+	for _, b := range *piece.blocks {
+		if b.Y < 0 {
+			above++
+		}
+	}
+
+	return (r.PartialLockOut && above > 0) || (r.LockOut && above == len(*piece.blocks))
+}
+
+// bounds returns the rectangle covered by the blocks of the piece
+func (piece *Piece) bounds() image.Rectangle {
+	r := image.Rectangle{}
+	for i, p := range *piece.blocks {
+		cell := image.Rect(p.X, p.Y, p.X+1, p.Y+1)
+		if i == 0 {
+			r = cell
+		} else {
+			r = r.Union(cell)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,69 @@
+package game
+
+import (
+	"image"
+	"testing"
+)
+
+func TestGuidelineSpawn(t *testing.T) {
+	testCases := []struct {
+		pieceType PieceType
+		expected  image.Rectangle
+	}{
+		{PieceTypeI, image.Rect(3, 0, 7, 1)},
+		{PieceTypeT, image.Rect(3, -1, 6, 1)},
+		{PieceTypeO, image.Rect(4, -1, 6, 1)},
+	}
+
+	rules := rulePresets[RulesGuideline]
+	for _, tc := range testCases {
+		p := newPlayField(0, 0, 10, 20, rules.HiddenRows, 1)
+		piece := createPiece(p, tc.pieceType)
+
+		if !rules.spawn(piece) {
+			t.Fatalf("piece %c blocked out on an empty playfield", tc.pieceType.Symbol())
+		}
+
+		if actual := piece.bounds(); actual != tc.expected {
+			t.Errorf("piece %c expected=%v actual=%v", tc.pieceType.Symbol(), tc.expected, actual)
+		}
+	}
+}
+
+func TestSpawnBlockOut(t *testing.T) {
+	p := newPlayField(0, 0, 10, 20, 2, 1)
+	for x := 0; x < 10; x++ {
+		p.SetCell(x, -1, newGarbageCell(0))
+	}
+
+	rules := rulePresets[RulesGuideline]
+	rules.HiddenRows = 2
+	if rules.spawn(createPiece(p, PieceTypeT)) {
+		t.Error("expected block out")
+	}
+
+	rules.BlockOut = false
+	piece := createPiece(p, PieceTypeT)
+	if !rules.spawn(piece) || piece.collides() {
+		t.Errorf("expected the piece to be pushed above the stack, actual %v", piece.bounds())
+	}
+}
+
+func TestLockOut(t *testing.T) {
+	p := newPlayField(0, 0, 10, 20, 2, 1)
+	piece := createPiece(p, PieceTypeT)
+	piece.translate(0, -1)
+
+	if (Rules{LockOut: true}).lockedOut(piece) {
+		t.Error("lock out with a block in the visible playfield")
+	}
+
+	if !(Rules{PartialLockOut: true}).lockedOut(piece) {
+		t.Error("expected partial lock out")
+	}
+
+	piece.translate(0, -1)
+	if !(Rules{LockOut: true}).lockedOut(piece) {
+		t.Error("expected lock out")
+	}
+}
@@ -0,0 +1,280 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/tinne26/etxt"
+)
+
+const (
+	defaultSandboxBoardFile = "sandbox.board"
+	sandboxPanelWidth       = 230
+	sandboxSwatchSize       = 30
+	sandboxSwatchColumns    = 5
+	sandboxMessageTicks     = 120
+)
+
+// SandboxScene is a practice board where cells are painted with the mouse and
+// the upcoming pieces are picked from a palette.
+type SandboxScene struct {
+	scene        *GameScene
+	path         string
+	gravity      bool
+	blocked      bool
+	brush        Cell
+	palette      []Cell
+	paletteRects []image.Rectangle
+	undo         []*Board
+	painting     bool
+	message      string
+	messageTicks int
+	text         *TextRenderer
+}
+
+func newSandboxScene(settings Settings) (*SandboxScene, error) {
+	scene, err := newGameScene(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SandboxScene{
+		scene:   scene,
+		path:    settings.BoardFile,
+		gravity: true,
+		text:    NewTextRenderer(RobotoRegularFontName, color.Black, 14, etxt.Top|etxt.Left),
+	}
+
+	if s.path == "" {
+		s.path = defaultSandboxBoardFile
+	}
+
+	for t := PieceTypeI; t <= PieceTypeDot; t++ {
+		s.palette = append(s.palette, newPieceCell(t, 0))
+	}
+	s.palette = append(s.palette, newGarbageCell(0))
+	s.brush = s.palette[len(s.palette)-1]
+
+	w, _ := scene.GetSize()
+	for i := range s.palette {
+		x := w + (i%sandboxSwatchColumns)*(sandboxSwatchSize+5)
+		y := scene.nextPieceRect.Min.Y + (i/sandboxSwatchColumns)*(sandboxSwatchSize+5)
+		s.paletteRects = append(s.paletteRects, image.Rect(x, y, x+sandboxSwatchSize, y+sandboxSwatchSize))
+	}
+
+	return s, nil
+}
+
+func (s *SandboxScene) GetSize() (screenWidth, screenHeight int) {
+	w, h := s.scene.GetSize()
+	return w + sandboxPanelWidth, h
+}
+
+func (s *SandboxScene) Update() error {
+	g := s.scene
+	g.ticks++
+
+	if s.messageTicks > 0 {
+		s.messageTicks--
+	}
+
+	s.handleEditorKeys()
+	s.handleMouse()
+
+	if s.blocked {
+		return nil
+	}
+
+	g.handleInput(g.input())
+
+	if !g.updateModifiers() {
+		s.block()
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		for g.currentPiece.MoveDown() {
+		}
+		s.lockPiece()
+	} else if s.gravity && g.updateGravity() {
+		s.lockPiece()
+	}
+
+	return nil
+}
+
+func (s *SandboxScene) lockPiece() {
+	s.pushUndo()
+	s.scene.lockPiece()
+
+	if s.scene.gameOver {
+		s.block()
+	}
+}
+
+func (s *SandboxScene) block() {
+	s.scene.gameOver = false
+	s.blocked = true
+	s.setMessage(s.scene.messages.Get(MessageBlocked))
+}
+
+func (s *SandboxScene) handleEditorKeys() {
+	g := s.scene
+	ctrl := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyG):
+		s.gravity = !s.gravity
+		g.moveDownCounter.Reset()
+	case inpututil.IsKeyJustPressed(ebiten.KeyBackspace), ctrl && inpututil.IsKeyJustPressed(ebiten.KeyZ):
+		s.popUndo()
+	case inpututil.IsKeyJustPressed(ebiten.KeyDelete):
+		s.pushUndo()
+		s.restore(NewBoard(g.playField.width, g.playField.height))
+	case inpututil.IsKeyJustPressed(ebiten.KeyQ):
+		g.queue = g.queue[:0]
+		g.updateNextPiece()
+	case inpututil.IsKeyJustPressed(ebiten.KeyF5):
+		if err := g.board().Save(s.path); err != nil {
+			s.setMessage(err.Error())
+		} else {
+			s.setMessage(g.messages.Get(MessageSaved) + " " + s.path)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyF9):
+		b, err := LoadBoard(s.path)
+		if err != nil {
+			s.setMessage(err.Error())
+			return
+		}
+
+		s.pushUndo()
+		s.restore(b)
+		s.setMessage(g.messages.Get(MessageLoaded) + " " + s.path)
+	}
+}
+
+func (s *SandboxScene) handleMouse() {
+	g := s.scene
+	mx, my := ebiten.CursorPosition()
+	cursor := image.Pt(mx, my)
+
+	for i, r := range s.paletteRects {
+		if !cursor.In(r) {
+			continue
+		}
+
+		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) && !s.palette[i].Garbage {
+			g.queue = append(g.queue, s.palette[i].Piece)
+			g.updateNextPiece()
+		} else if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
+			s.brush = s.palette[i]
+		}
+
+		return
+	}
+
+	left := ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	right := ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
+	if !left && !right {
+		s.painting = false
+		return
+	}
+
+	p := g.playField
+	x, y := (mx-p.x)/p.tileSize, (my-p.y)/p.tileSize
+	if mx < p.x || my < p.y || x >= p.width || y >= p.height {
+		return
+	}
+
+	if !s.painting {
+		s.pushUndo()
+		s.painting = true
+	}
+
+	if left {
+		c := s.brush
+		c.LockedAt = g.ticks
+		p.SetCell(x, y, c)
+	} else {
+		p.SetCell(x, y, Cell{})
+	}
+}
+
+func (s *SandboxScene) pushUndo() {
+	s.undo = append(s.undo, s.scene.board())
+}
+
+func (s *SandboxScene) popUndo() {
+	if len(s.undo) == 0 {
+		return
+	}
+
+	b := s.undo[len(s.undo)-1]
+	s.undo = s.undo[:len(s.undo)-1]
+	s.restore(b)
+}
+
+func (s *SandboxScene) restore(b *Board) {
+	g := s.scene
+	if err := g.loadBoard(b); err != nil {
+		s.setMessage(err.Error())
+		return
+	}
+
+	s.blocked = !g.setNewPiece()
+}
+
+func (s *SandboxScene) setMessage(message string) {
+	s.message = message
+	s.messageTicks = sandboxMessageTicks
+}
+
+func (s *SandboxScene) Draw(screen *ebiten.Image) {
+	g := s.scene
+	g.Draw(screen)
+
+	w, _ := g.GetSize()
+	s.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
+	s.text.Draw(screen, g.messages.Get(MessagePalette), w, g.nextPieceRect.Min.Y-25)
+
+	for i, r := range s.paletteRects {
+		c := s.palette[i]
+		if c == s.brush {
+			vector.StrokeRect(screen, float32(r.Min.X)-2, float32(r.Min.Y)-2, float32(r.Dx())+4, float32(r.Dy())+4, 2, color.Black, false)
+		}
+
+		fillRect(screen, float32(r.Min.X), float32(r.Min.Y), float32(r.Dx()), float32(r.Dy()), g.playField.theme.CellColor(c))
+		s.text.SetColor(color.Black)
+		s.text.Draw(screen, string(cellSymbol(c)), r.Min.X+10, r.Min.Y+7)
+	}
+
+	queue := make([]string, len(g.queue))
+	for i, t := range g.queue {
+		queue[i] = string(t.Symbol())
+	}
+
+	gravity := g.messages.Get(MessageOff)
+	if s.gravity {
+		gravity = g.messages.Get(MessageOn)
+	}
+
+	y := s.paletteRects[len(s.paletteRects)-1].Max.Y + 20
+	s.text.SetColor(color.Black)
+	s.text.Draw(screen, strings.Join([]string{
+		g.messages.Get(MessageQueue) + ": " + strings.Join(queue, " "),
+		g.messages.Get(MessageGravity) + ": " + gravity,
+		fmt.Sprintf("%s: %d", g.messages.Get(MessageUndoSteps), len(s.undo)),
+		"",
+		g.messages.Get(MessageSandboxHelp),
+	}, "\n"), w, y)
+
+	if s.messageTicks > 0 {
+		s.text.SetColor(color.RGBA{R: 170, G: 50, B: 50, A: 255})
+		s.text.Draw(screen, s.message, w, screen.Bounds().Dy()-30)
+	}
+}
@@ -0,0 +1,22 @@
+package game
+
+type Settings struct {
+	GravityCurve string
+	Cascade      bool
+	BoardFile    string
+	Sandbox      bool
+	Modifiers    string
+	// Language of the HUD and menu texts, empty means the OS locale
+	Language string
+	Rules    Rules
+	Finesse  bool
+	// FinesseRetry makes the finesse trainer repeat a placement until it is done without a fault
+	FinesseRetry bool
+}
+
+func DefaultSettings() Settings {
+	return Settings{
+		GravityCurve: GravityCurveGuideline,
+		Rules:        rulePresets[RulesGuideline],
+	}
+}
@@ -78,6 +78,7 @@ func (t *TextRenderer) Draw(target *ebiten.Image, text string, x, y int) {
 	t.renderer.SetSize(t.size)
 	t.renderer.SetAlign(t.align)
 	t.renderer.Draw(target, text, x, y)
+	drawn()
 }
 
 func (t *TextRenderer) SetColor(color color.Color) {
@@ -0,0 +1,46 @@
+package game
+
+import "image/color"
+
+type Theme struct {
+	Empty   color.Color
+	Garbage color.Color
+	Pieces  map[PieceType]color.Color
+}
+
+var defaultTheme = &Theme{
+	Empty:   color.RGBA{0, 0, 0, 220},
+	Garbage: color.RGBA{R: 120, G: 120, B: 120, A: 255},
+	Pieces: map[PieceType]color.Color{
+		PieceTypeI:   color.RGBA{R: 175, G: 238, B: 238, A: 255},
+		PieceTypeJ:   color.RGBA{R: 137, G: 207, B: 240, A: 255},
+		PieceTypeL:   color.RGBA{R: 255, G: 179, B: 102, A: 255},
+		PieceTypeO:   color.RGBA{R: 253, G: 253, B: 150, A: 255},
+		PieceTypeS:   color.RGBA{R: 119, G: 221, B: 119, A: 255},
+		PieceTypeT:   color.RGBA{R: 216, G: 191, B: 216, A: 255},
+		PieceTypeZ:   color.RGBA{R: 255, G: 153, B: 153, A: 255},
+		PieceTypeII:  color.RGBA{R: 200, G: 200, B: 200, A: 255},
+		PieceTypeIII: color.RGBA{R: 181, G: 101, B: 29, A: 255},
+		PieceTypeDot: color.RGBA{R: 191, G: 255, B: 164, A: 255},
+	},
+}
+
+func (t *Theme) PieceColor(p PieceType) color.Color {
+	if c, ok := t.Pieces[p]; ok {
+		return c
+	}
+
+	return t.Garbage
+}
+
+func (t *Theme) CellColor(c Cell) color.Color {
+	if c.IsEmpty() {
+		return t.Empty
+	}
+
+	if c.Garbage {
+		return t.Garbage
+	}
+
+	return t.PieceColor(c.Piece)
+}
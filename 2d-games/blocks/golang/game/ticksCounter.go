@@ -1,27 +1,54 @@
 package game
 
+// TicksCounter fires steps at a rational rate of steps per ticks, so it can
+// express both slow rates (1 step every n ticks) and rates of several steps
+// per tick.
 type TicksCounter struct {
 	ticks int
+	steps int
 	value int
 }
 
 func NewTicksCounter(ticks int) *TicksCounter {
 	return &TicksCounter{
 		ticks: ticks,
+		steps: 1,
 		value: 0,
 	}
 }
 
 func (t *TicksCounter) Update() bool {
-	t.value = (t.value + 1) % t.ticks
+	return t.Advance() > 0
+}
+
+// Advance moves the counter one tick forward and returns how many steps are
+// due in that tick.
+func (t *TicksCounter) Advance() int {
+	t.value += t.steps
+	n := t.value / t.ticks
+	t.value %= t.ticks
 
-	return t.value == 0
+	return n
 }
 
 func (t *TicksCounter) SetTicks(ticks int) {
+	t.SetRate(1, ticks)
+}
+
+func (t *TicksCounter) SetRate(steps, ticks int) {
 	if ticks <= 0 {
 		panic("ticks must be bigger than 0")
 	}
 
+	if steps <= 0 {
+		panic("steps must be bigger than 0")
+	}
+
+	t.steps = steps
 	t.ticks = ticks
+	t.value %= ticks
+}
+
+func (t *TicksCounter) Reset() {
+	t.value = 0
 }
@@ -6,6 +6,7 @@ require (
 	github.com/hajimehoshi/ebiten/v2 v2.8.6
 	github.com/tinne26/etxt v0.0.9
 	golang.org/x/image v0.24.0
+	golang.org/x/sys v0.25.0
 )
 
 require (
@@ -14,6 +15,5 @@ require (
 	github.com/ebitengine/purego v0.8.0 // indirect
 	github.com/jezek/xgb v1.1.1 // indirect
 	golang.org/x/sync v0.11.0 // indirect
-	golang.org/x/sys v0.25.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
 )
@@ -1,14 +1,42 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/DTVegaArchChapter/GameProgramming/blocks/game"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func main() {
-	game := game.NewGame()
+	settings := game.DefaultSettings()
+	flag.StringVar(&settings.GravityCurve, "gravity", settings.GravityCurve, fmt.Sprintf("gravity curve (%s)", strings.Join(game.GravityCurveNames(), ", ")))
+	flag.BoolVar(&settings.Cascade, "cascade", settings.Cascade, "let connected blocks fall as units after line clears")
+	flag.StringVar(&settings.BoardFile, "board", settings.BoardFile, "board file to start from")
+	flag.BoolVar(&settings.Sandbox, "sandbox", settings.Sandbox, "start the board editor and practice sandbox")
+	flag.StringVar(&settings.Modifiers, "modifiers", settings.Modifiers, fmt.Sprintf("comma separated game modifiers (%s) with optional values, e.g. invisible:3,big,mirror:10,rising:10", strings.Join(game.ModifierNames(), ", ")))
+	flag.BoolVar(&settings.Finesse, "finesse", settings.Finesse, "start the finesse trainer")
+	flag.BoolVar(&settings.FinesseRetry, "finesse-retry", settings.FinesseRetry, "repeat a finesse trainer placement until it is done without a fault")
+	flag.StringVar(&settings.Language, "lang", settings.Language, "language of the texts (en, tr), defaults to the OS locale")
+	flag.Func("rules", fmt.Sprintf("spawn and top out rule preset (%s), the single rule flags after it override the preset", strings.Join(game.RulesNames(), ", ")), func(name string) error {
+		rules, err := game.GetRules(name)
+		settings.Rules = rules
+		return err
+	})
+	flag.BoolVar(&settings.Rules.GuidelineSpawn, "guideline-spawn", settings.Rules.GuidelineSpawn, "spawn pieces centred above the playfield instead of the top left")
+	flag.IntVar(&settings.Rules.HiddenRows, "hidden-rows", settings.Rules.HiddenRows, "rows above the visible playfield, 0 or 2-20")
+	flag.BoolVar(&settings.Rules.LockOut, "lock-out", settings.Rules.LockOut, "end the game when a piece locks completely above the visible playfield")
+	flag.BoolVar(&settings.Rules.PartialLockOut, "partial-lock-out", settings.Rules.PartialLockOut, "end the game when any block locks above the visible playfield")
+	flag.BoolVar(&settings.Rules.BlockOut, "block-out", settings.Rules.BlockOut, "end the game when a new piece overlaps the stack")
+	flag.Parse()
+
+	game, err := game.NewGame(settings)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	w, h := game.GetSize()
 	ebiten.SetWindowSize(w, h)
 	ebiten.SetWindowTitle("Blocks")
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+const ansiReset = "\x1b[0m"
+
+// ansiColors are the background colours of the tiles, bold white letters on green, yellow and gray like the game
+var ansiColors = map[core.CharacterStatus]string{
+	core.CharacterStatusCorrectLocation: "\x1b[1;97;42m",
+	core.CharacterStatusWrongLocation:   "\x1b[1;97;43m",
+	core.CharacterStatusNotPresent:      "\x1b[1;97;100m",
+}
+
+// wordle-cli plays Wordle in the terminal, the guesses are read line by line from the standard input
+// so a game can be scripted. The exit code is 1 when the game is not won.
+func main() {
+	lang := flag.String("lang", core.Turkish.Name, fmt.Sprintf("language of the words and the keyboard (%s)", strings.Join(core.LanguageNames(), ", ")))
+	length := flag.Int("length", 5, fmt.Sprintf("word length, %d-%d, the Turkish word lists only have 5 letter words", core.MinWordLength, core.MaxWordLength))
+	guesses := flag.Int("guesses", 6, fmt.Sprintf("number of guesses, %d-%d", core.MinGuesses, core.MaxGuesses))
+	mode := flag.String("mode", core.ModePractice, fmt.Sprintf("%s: a random word, %s: the puzzle of the day, %s: no fixed answer, the game dodges the guesses", core.ModePractice, core.ModeDaily, core.ModeAbsurdle))
+	hard := flag.Bool("hard", false, "hard mode, every guess has to use the revealed hints")
+	plain := flag.Bool("plain", os.Getenv("NO_COLOR") != "", "print [K] for a correct letter and (K) for a letter in the wrong location instead of colours")
+	flag.Parse()
+
+	language, err := core.GetLanguage(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dict, err := core.NewLanguageDictionary(language, *length)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	board, err := core.NewModeBoard(dict, *mode, *guesses, *hard, time.Now())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !play(board, language, os.Stdin, os.Stdout, !*plain) {
+		os.Exit(1)
+	}
+}
+
+// play reads the guesses from in until the board is finished and reports whether it is won
+func play(board *core.Board, language *core.Language, in io.Reader, out io.Writer, colors bool) bool {
+	fmt.Fprintln(out, language.Message(core.MessageTitle))
+
+	scanner := bufio.NewScanner(in)
+	for board.State() == core.StateInProgress {
+		fmt.Fprintf(out, "%d/%d> ", len(board.Guesses())+1, board.MaxGuesses())
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			break
+		}
+
+		guess := strings.TrimSpace(scanner.Text())
+		if guess == "" {
+			continue
+		}
+
+		result, err := board.Submit(guess)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			continue
+		}
+
+		fmt.Fprintln(out, row([]rune(language.Upper(guess)), result, colors))
+		fmt.Fprintln(out)
+		printKeyboard(out, language, board.LetterStatuses(), colors)
+	}
+
+	switch board.State() {
+	case core.StateWon:
+		fmt.Fprintln(out, language.Message(core.MessageYouWon))
+		return true
+	case core.StateLost:
+		fmt.Fprintf(out, language.Message(core.MessageAnswer)+"\n", board.Answer())
+	}
+
+	return false
+}
+
+// printKeyboard prints the keyboard rows of the language with the letters coloured by what the guesses revealed
+func printKeyboard(out io.Writer, language *core.Language, statuses map[rune]core.CharacterStatus, colors bool) {
+	for i, keys := range language.KeyboardRows {
+		letters := []rune(keys)
+		result := make([]core.CharacterStatus, len(letters))
+		for j, r := range letters {
+			result[j] = statuses[r]
+			if !colors && result[j] == core.CharacterStatusNotPresent {
+				// without colours the letters which are not in the answer are hidden
+				letters[j], result[j] = '·', core.CharacterStatusNone
+			}
+		}
+
+		fmt.Fprintf(out, "%s%s\n", strings.Repeat(" ", i), row(letters, result, colors))
+	}
+	fmt.Fprintln(out)
+}
+
+// row formats the letters with their statuses, a letter without a status is printed as it is
+func row(letters []rune, result []core.CharacterStatus, colors bool) string {
+	var b strings.Builder
+	for i, r := range letters {
+		s := result[i]
+		switch {
+		case colors && s != core.CharacterStatusNone:
+			fmt.Fprintf(&b, "%s %c %s", ansiColors[s], r, ansiReset)
+		case s == core.CharacterStatusCorrectLocation:
+			fmt.Fprintf(&b, "[%c]", r)
+		case s == core.CharacterStatusWrongLocation:
+			fmt.Fprintf(&b, "(%c)", r)
+		default:
+			fmt.Fprintf(&b, " %c ", r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func newBoard(t *testing.T, guesses int) *core.Board {
+	t.Helper()
+
+	b := core.NewBoard(core.NewDictionary(), guesses, false)
+	b.SetAnswer("KAŞIK")
+
+	return b
+}
+
+func TestPlay(t *testing.T) {
+	testCases := []struct {
+		name     string
+		guesses  int
+		input    string
+		won      bool
+		expected []string
+	}{
+		{
+			name:    "won",
+			guesses: 6,
+			input:   "şapka\nzzzzz\n\nkaşık\n",
+			won:     true,
+			expected: []string{
+				"1/6> (Ş)[A] P (K) A \n",
+				"2/6> " + core.Turkish.Message(core.MessageNotInWordList) + "\n",
+				"2/6> 2/6> [K][A][Ş][I][K]\n",
+				core.Turkish.Message(core.MessageYouWon) + "\n",
+			},
+		},
+		{
+			name:    "lost",
+			guesses: 4,
+			input:   "şapka\nşapka\nşapka\nşapka\n",
+			expected: []string{
+				"4/4> (Ş)[A] P (K) A \n",
+				fmt.Sprintf(core.Turkish.Message(core.MessageAnswer), "KAŞIK") + "\n",
+			},
+		},
+		{
+			name:    "input ends",
+			guesses: 6,
+			input:   "şapka\n",
+			expected: []string{
+				"1/6> (Ş)[A] P (K) A \n",
+				"2/6> \n",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out strings.Builder
+			if won := play(newBoard(t, tc.guesses), core.Turkish, strings.NewReader(tc.input), &out, false); won != tc.won {
+				t.Errorf("expected won=%v actual=%v", tc.won, won)
+			}
+
+			output := out.String()
+			if !strings.HasPrefix(output, core.Turkish.Message(core.MessageTitle)+"\n") {
+				t.Errorf("the output should start with the title:\n%s", output)
+			}
+
+			for _, e := range tc.expected {
+				if !strings.Contains(output, e) {
+					t.Errorf("the output should contain %q:\n%s", e, output)
+				}
+			}
+		})
+	}
+}
+
+func TestPlayColors(t *testing.T) {
+	var out strings.Builder
+	play(newBoard(t, 6), core.Turkish, strings.NewReader("kaşık\n"), &out, true)
+
+	expected := ansiColors[core.CharacterStatusCorrectLocation] + " K " + ansiReset
+	if !strings.Contains(out.String(), expected) {
+		t.Errorf("the output should contain the coloured tile %q:\n%s", expected, out.String())
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/server"
+)
+
+// wordle-server serves the game as an HTTP/JSON API for bots and other front-ends, see server.Server for the endpoints
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	ttl := flag.Duration("ttl", 30*time.Minute, "time a game is kept after its last request")
+	flag.Parse()
+
+	log.Printf("listening on http://%s", *addr)
+	if err := http.ListenAndServe(*addr, server.New(*ttl)); err != nil {
+		log.Fatal(err)
+	}
+}
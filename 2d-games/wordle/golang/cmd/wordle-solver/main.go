@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/solver"
+)
+
+// wordle-solver plays every target word with the solver and reports how many guesses it needs
+func main() {
+	lang := flag.String("lang", core.Turkish.Name, fmt.Sprintf("language of the words (%s)", strings.Join(core.LanguageNames(), ", ")))
+	length := flag.Int("length", 5, fmt.Sprintf("word length, %d-%d, the Turkish word lists only have 5 letter words", core.MinWordLength, core.MaxWordLength))
+	guesses := flag.Int("guesses", 6, fmt.Sprintf("number of guesses a game is won in, %d-%d", core.MinGuesses, core.MaxGuesses))
+	hard := flag.Bool("hard", false, "only use the guesses which keep the revealed hints")
+	verbose := flag.Bool("v", false, "print the guesses of every game")
+	flag.Parse()
+
+	if *guesses < core.MinGuesses || *guesses > core.MaxGuesses {
+		log.Fatalf("guesses must be between %d and %d, got %d", core.MinGuesses, core.MaxGuesses, *guesses)
+	}
+
+	language, err := core.GetLanguage(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dict, err := core.NewLanguageDictionary(language, *length)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := solver.New(dict)
+	s.HardMode = *hard
+
+	targets := dict.Targets()
+	distribution := make([]int, *guesses)
+	total, lost := 0, 0
+	for _, t := range targets {
+		played := s.Solve(t, *guesses)
+
+		if *verbose {
+			words := make([]string, len(played))
+			for i, g := range played {
+				words[i] = g.Word
+			}
+			fmt.Printf("%s: %s\n", t, strings.Join(words, " "))
+		}
+
+		if !played[len(played)-1].Solved() {
+			lost++
+			continue
+		}
+
+		total += len(played)
+		distribution[len(played)-1]++
+	}
+
+	fmt.Printf("first guess: %s\n", s.Best(nil))
+	fmt.Printf("solved %d of %d words, average %.3f guesses\n", len(targets)-lost, len(targets), float64(total)/float64(max(1, len(targets)-lost)))
+	for i, c := range distribution {
+		fmt.Printf("%d: %d\n", i+1, c)
+	}
+	fmt.Printf("X: %d\n", lost)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+const usage = `usage: wordlist lint [flags]
+
+lint checks the word lists for blank lines, word lengths, letters outside the alphabet, duplicates
+and targets which are not in the dictionary, the problems are reported with their line numbers.
+Without a list or a pack the built-in lists are checked. A list file is checked
+against one language, so -dict and -targets need -lang.
+
+flags:
+`
+
+// wordlist checks word lists before they are used in the game
+func main() {
+	log.SetFlags(0)
+
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	lang := fs.String("lang", "", fmt.Sprintf("language of the lists (%s), every language when empty", strings.Join(core.LanguageNames(), ", ")))
+	dictPath := fs.String("dict", "", "dictionary file, the built-in dictionary of the language when empty, needs -lang")
+	targetsPath := fs.String("targets", "", "targets file, the built-in targets of the language when empty, needs -lang")
+	packs := fs.String("packs", "", "folder of language packs like packs/en/dict.txt and packs/en/target.txt")
+
+	if len(os.Args) < 2 || os.Args[1] != "lint" {
+		fs.Usage()
+		os.Exit(2)
+	}
+	fs.Parse(os.Args[2:])
+
+	if *lang == "" && (*dictPath != "" || *targetsPath != "") {
+		log.Fatal("-dict and -targets need -lang, a list file is checked against one language")
+	}
+
+	languages, err := lintLanguages(*lang, *packs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	problems := 0
+	for _, l := range languages {
+		dict, targets := l.WordLists()
+		if *packs != "" {
+			if dict, targets, err = core.ReadLanguagePack(*packs, l); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		if *dictPath != "" {
+			if dict, err = core.ReadWordList(*dictPath); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		if *targetsPath != "" {
+			if targets, err = core.ReadWordList(*targetsPath); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		for _, p := range core.LintWordLists(l, dict, targets) {
+			fmt.Println(p)
+			problems++
+		}
+	}
+
+	if problems > 0 {
+		log.Fatalf("%d problems found", problems)
+	}
+}
+
+// lintLanguages returns the language of the flag, the languages of the packs or every language
+func lintLanguages(lang, packs string) ([]*core.Language, error) {
+	if lang != "" {
+		l, err := core.GetLanguage(lang)
+		return []*core.Language{l}, err
+	}
+
+	if packs != "" {
+		return core.PackLanguages(packs)
+	}
+
+	var languages []*core.Language
+	for _, name := range core.LanguageNames() {
+		l, _ := core.GetLanguage(name)
+		languages = append(languages, l)
+	}
+
+	return languages, nil
+}
@@ -0,0 +1,74 @@
+package core
+
+import "sort"
+
+// Partition is a group of words which give the same result for a guess
+type Partition struct {
+	Result []CharacterStatus
+	Words  []string
+}
+
+// PartitionWords groups the words by the result CheckAnswerRunes gives for the guess when the word is the answer.
+// The partitions are sorted from the largest to the smallest.
+func PartitionWords(language *Language, guess []rune, words []string) []Partition {
+	index := map[string]int{}
+	var partitions []Partition
+
+	for _, w := range words {
+		result := language.CheckAnswerRunes(guess, []rune(w))
+		key := resultKey(result)
+
+		i, ok := index[key]
+		if !ok {
+			i = len(partitions)
+			index[key] = i
+			partitions = append(partitions, Partition{Result: result})
+		}
+
+		partitions[i].Words = append(partitions[i].Words, w)
+	}
+
+	sort.SliceStable(partitions, func(i, j int) bool {
+		if len(partitions[i].Words) != len(partitions[j].Words) {
+			return len(partitions[i].Words) > len(partitions[j].Words)
+		}
+
+		// on equal sizes the result revealing less comes first
+		return resultKey(partitions[i].Result) < resultKey(partitions[j].Result)
+	})
+
+	return partitions
+}
+
+func resultKey(result []CharacterStatus) string {
+	key := make([]byte, len(result))
+	for i, s := range result {
+		key[i] = byte('0' + s)
+	}
+
+	return string(key)
+}
+
+// Adversary plays Absurdle, there is no fixed answer and every guess gets the result which keeps the most words possible
+type Adversary struct {
+	language   *Language
+	candidates []string
+}
+
+func NewAdversary(language *Language, targets []string) *Adversary {
+	return &Adversary{language: language, candidates: targets}
+}
+
+// Candidates returns the words the answer can still be
+func (a *Adversary) Candidates() []string {
+	return a.candidates
+}
+
+// Check returns the result of the guess which keeps the largest partition of the candidates.
+// The guess is correct only when it is the last candidate left.
+func (a *Adversary) Check(guess []rune) []CharacterStatus {
+	largest := PartitionWords(a.language, guess, a.candidates)[0]
+	a.candidates = largest.Words
+
+	return largest.Result
+}
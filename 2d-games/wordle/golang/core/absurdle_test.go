@@ -0,0 +1,53 @@
+package core_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestPartitionWords(t *testing.T) {
+	words := []string{"KAÇIŞ", "KARIN", "KAPAK", "SALON", "ŞAPKA", "MASAL"}
+	partitions := core.PartitionWords(core.Turkish, []rune("KALIN"), words)
+
+	total := 0
+	for i, p := range partitions {
+		total += len(p.Words)
+
+		if i > 0 && len(p.Words) > len(partitions[i-1].Words) {
+			t.Errorf("partitions are not sorted by size")
+		}
+
+		for _, w := range p.Words {
+			if r := core.Turkish.CheckAnswerRunes([]rune("KALIN"), []rune(w)); !slices.Equal(r, p.Result) {
+				t.Errorf("word %s gives %v, expected the partition result %v", w, r, p.Result)
+			}
+		}
+	}
+
+	if total != len(words) {
+		t.Errorf("partitions have %d words, expected %d", total, len(words))
+	}
+}
+
+func TestAdversary(t *testing.T) {
+	a := core.NewAdversary(core.Turkish, []string{"KAÇIŞ", "KARIN", "SALON"})
+
+	// KARIN splits the words into three partitions of one, the one revealing the least is kept
+	a.Check([]rune("KARIN"))
+	if len(a.Candidates()) != 1 {
+		t.Fatalf("expected one candidate, got %v", a.Candidates())
+	}
+
+	if a.Candidates()[0] == "KARIN" {
+		t.Error("the guessed word should not be kept while other words are left")
+	}
+
+	last := a.Candidates()[0]
+	for _, s := range a.Check([]rune(last)) {
+		if s != core.CharacterStatusCorrectLocation {
+			t.Errorf("guessing the last candidate %s should win", last)
+		}
+	}
+}
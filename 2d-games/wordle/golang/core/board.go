@@ -0,0 +1,228 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MinGuesses = 4
+	MaxGuesses = 10
+
+	ModePractice = "practice"
+	ModeDaily    = "daily"
+	// ModeAbsurdle has no fixed answer, every guess gets the result which keeps the most words possible
+	ModeAbsurdle = "absurdle"
+)
+
+// State is the state of a board, the board does not take guesses once it is lost or won
+type State int
+
+const (
+	StateInProgress State = iota
+	StateLost
+	StateWon
+)
+
+var stateNames = map[State]string{
+	StateInProgress: "in_progress",
+	StateLost:       "lost",
+	StateWon:        "won",
+}
+
+func (s State) MarshalText() ([]byte, error) {
+	name, ok := stateNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown state %d", int(s))
+	}
+
+	return []byte(name), nil
+}
+
+func (s *State) UnmarshalText(text []byte) error {
+	for state, name := range stateNames {
+		if name == string(text) {
+			*s = state
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown state '%s'", text)
+}
+
+// Board holds the rules of a game of Wordle without the drawing: the answer, the scored guesses,
+// the hints of hard mode and the state of the game
+type Board struct {
+	dict *Dictionary
+	// answer is nil on an absurdle board, the adversary scores the guesses instead
+	answer     []rune
+	adversary  *Adversary
+	maxGuesses int
+	hardMode   bool
+	guesses    []string
+	results    [][]CharacterStatus
+	hints      *Hints
+	state      State
+}
+
+// NewBoard creates a board playing a random answer of the dictionary
+func NewBoard(dict *Dictionary, maxGuesses int, hardMode bool) *Board {
+	b := newBoard(dict, maxGuesses, hardMode)
+	b.answer = []rune(dict.GetRandomWord())
+
+	return b
+}
+
+// NewAbsurdleBoard creates a board without a fixed answer, every guess gets the result which keeps the most words possible
+func NewAbsurdleBoard(dict *Dictionary, maxGuesses int, hardMode bool) *Board {
+	b := newBoard(dict, maxGuesses, hardMode)
+	b.adversary = NewAdversary(dict.language, dict.Targets())
+
+	return b
+}
+
+// NewModeBoard creates a board of the mode, a random answer in practice mode and the puzzle of the day of now in daily mode
+func NewModeBoard(dict *Dictionary, mode string, maxGuesses int, hardMode bool, now time.Time) (*Board, error) {
+	if maxGuesses < MinGuesses || maxGuesses > MaxGuesses {
+		return nil, fmt.Errorf("guesses must be between %d and %d, got %d", MinGuesses, MaxGuesses, maxGuesses)
+	}
+
+	switch mode {
+	case ModePractice:
+		return NewBoard(dict, maxGuesses, hardMode), nil
+	case ModeDaily:
+		b := NewBoard(dict, maxGuesses, hardMode)
+		b.SetAnswer(dict.DailyWord(DailyPuzzleNumber(now)))
+
+		return b, nil
+	case ModeAbsurdle:
+		return NewAbsurdleBoard(dict, maxGuesses, hardMode), nil
+	}
+
+	return nil, fmt.Errorf("unknown mode '%s', available modes: %s, %s, %s", mode, ModePractice, ModeDaily, ModeAbsurdle)
+}
+
+func newBoard(dict *Dictionary, maxGuesses int, hardMode bool) *Board {
+	return &Board{
+		dict:       dict,
+		maxGuesses: maxGuesses,
+		hardMode:   hardMode,
+		hints:      NewHints(dict.wordLength),
+	}
+}
+
+// Answer returns the answer, on an absurdle board any of the words left can be the answer and the first is returned
+func (b *Board) Answer() string {
+	if b.adversary != nil {
+		return b.adversary.Candidates()[0]
+	}
+
+	return string(b.answer)
+}
+
+// SetAnswer replaces the answer of a board without guesses, it is used for the daily puzzle and the saved games
+func (b *Board) SetAnswer(answer string) {
+	if b.adversary == nil && len(b.guesses) == 0 {
+		b.answer = []rune(b.dict.language.Upper(answer))
+	}
+}
+
+func (b *Board) State() State {
+	return b.state
+}
+
+func (b *Board) MaxGuesses() int {
+	return b.maxGuesses
+}
+
+func (b *Board) WordLength() int {
+	return b.dict.wordLength
+}
+
+// Guesses returns the upper-case words of the scored guesses
+func (b *Board) Guesses() []string {
+	return b.guesses
+}
+
+// Results returns the results of the scored guesses in the order of Guesses
+func (b *Board) Results() [][]CharacterStatus {
+	return b.results
+}
+
+// Validate returns the reason the guess cannot be submitted in the language of the dictionary,
+// the word has to have the length of the answer, be in the dictionary and in hard mode use the revealed hints
+func (b *Board) Validate(guess string) error {
+	language := b.dict.language
+	if b.state != StateInProgress {
+		return errors.New("the game is over")
+	}
+
+	if utf8.RuneCountInString(guess) != b.dict.wordLength {
+		return fmt.Errorf(language.Message(MessageWordLength), b.dict.wordLength)
+	}
+
+	if !b.dict.WordExists(guess) {
+		return errors.New(language.Message(MessageNotInWordList))
+	}
+
+	if b.hardMode {
+		if v, ok := b.hints.Violation([]rune(language.Upper(guess))); ok {
+			return errors.New(language.HintMessage(v))
+		}
+	}
+
+	return nil
+}
+
+// Submit scores the guess, the board is won when every letter is correct and lost when it runs out of guesses
+func (b *Board) Submit(guess string) ([]CharacterStatus, error) {
+	if err := b.Validate(guess); err != nil {
+		return nil, err
+	}
+
+	word := []rune(b.dict.language.Upper(guess))
+	var result []CharacterStatus
+	if b.adversary != nil {
+		result = b.adversary.Check(word)
+	} else {
+		result = b.dict.language.CheckAnswerRunes(word, b.answer)
+	}
+
+	b.hints.Add(word, result)
+	b.guesses = append(b.guesses, string(word))
+	b.results = append(b.results, result)
+
+	switch {
+	case solved(result):
+		b.state = StateWon
+	case len(b.guesses) >= b.maxGuesses:
+		b.state = StateLost
+	}
+
+	return result, nil
+}
+
+// LetterStatuses returns the best status of every guessed letter, a letter found in the correct location
+// stays correct and a letter present in the answer is never shown as not present again
+func (b *Board) LetterStatuses() map[rune]CharacterStatus {
+	statuses := map[rune]CharacterStatus{}
+	for i, g := range b.guesses {
+		for j, r := range []rune(g) {
+			statuses[r] = max(statuses[r], b.results[i][j])
+		}
+	}
+
+	return statuses
+}
+
+func solved(result []CharacterStatus) bool {
+	for _, s := range result {
+		if s != CharacterStatusCorrectLocation {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,123 @@
+package core_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestBoardSubmit(t *testing.T) {
+	b := core.NewBoard(core.NewDictionary(), 3, false)
+	b.SetAnswer("kaşık")
+
+	if b.Answer() != "KAŞIK" {
+		t.Fatalf("expected answer KAŞIK, got %s", b.Answer())
+	}
+
+	for _, guess := range []string{"kale", "zzzzz"} {
+		if _, err := b.Submit(guess); err == nil {
+			t.Errorf("expected %s to be rejected", guess)
+		}
+	}
+
+	result, err := b.Submit("şapka")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(result, core.Turkish.CheckAnswerRunes([]rune("ŞAPKA"), []rune("KAŞIK"))) || b.State() != core.StateInProgress {
+		t.Errorf("unexpected result %v state %v", result, b.State())
+	}
+
+	if _, err := b.Submit("KAŞIK"); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.State() != core.StateWon || !slices.Equal(b.Guesses(), []string{"ŞAPKA", "KAŞIK"}) {
+		t.Errorf("unexpected state %v guesses %v", b.State(), b.Guesses())
+	}
+
+	if _, err := b.Submit("KALEM"); err == nil {
+		t.Error("a finished board should not take guesses")
+	}
+}
+
+func TestBoardLost(t *testing.T) {
+	b := core.NewBoard(core.NewDictionary(), 2, false)
+	b.SetAnswer("KAŞIK")
+
+	for _, guess := range []string{"ŞAPKA", "KALEM"} {
+		if _, err := b.Submit(guess); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if b.State() != core.StateLost {
+		t.Errorf("expected the board to be lost, got %v", b.State())
+	}
+}
+
+func TestBoardHardMode(t *testing.T) {
+	b := core.NewBoard(core.NewDictionary(), 6, true)
+	b.SetAnswer("KAŞIK")
+
+	if _, err := b.Submit("ŞAPKA"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Validate("KAŞIK"); err != nil {
+		t.Errorf("KAŞIK uses every hint: %s", err)
+	}
+
+	if err := b.Validate("MELEK"); err == nil || err.Error() != "2. harf A olmalı" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestBoardLetterStatuses(t *testing.T) {
+	b := core.NewBoard(core.NewDictionary(), 6, false)
+	b.SetAnswer("KAŞIK")
+
+	for _, guess := range []string{"ŞAPKA", "KALEM"} {
+		if _, err := b.Submit(guess); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := map[rune]core.CharacterStatus{
+		'Ş': core.CharacterStatusWrongLocation,
+		'A': core.CharacterStatusCorrectLocation,
+		'P': core.CharacterStatusNotPresent,
+		'K': core.CharacterStatusCorrectLocation,
+		'L': core.CharacterStatusNotPresent,
+		'E': core.CharacterStatusNotPresent,
+		'M': core.CharacterStatusNotPresent,
+	}
+
+	actual := b.LetterStatuses()
+	if len(actual) != len(expected) {
+		t.Errorf("expected=%v actual=%v", expected, actual)
+	}
+
+	for r, s := range expected {
+		if actual[r] != s {
+			t.Errorf("%c expected=%v actual=%v", r, s, actual[r])
+		}
+	}
+}
+
+func TestAbsurdleBoard(t *testing.T) {
+	dict := core.NewDictionary()
+	b := core.NewAbsurdleBoard(dict, 6, false)
+	b.SetAnswer("KAŞIK")
+
+	if _, err := b.Submit("ŞAPKA"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := core.PartitionWords(core.Turkish, []rune("ŞAPKA"), dict.Targets())[0]
+	if !slices.Equal(b.Results()[0], expected.Result) || b.Answer() != expected.Words[0] {
+		t.Errorf("unexpected result %v answer %s", b.Results()[0], b.Answer())
+	}
+}
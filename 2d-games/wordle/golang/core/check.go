@@ -0,0 +1,47 @@
+package core
+
+// CheckAnswerRunes returns the result of the guess for the answer, the letters are compared in the casing of the language
+func (l *Language) CheckAnswerRunes(answer, correct []rune) []CharacterStatus {
+	result := make([]CharacterStatus, len(answer))
+	left := []rune{}
+
+	for i := 0; i < len(answer); i++ {
+		a := answer[i]
+		c := correct[i]
+		if l.ToUpper(a) == l.ToUpper(c) {
+			result[i] = CharacterStatusCorrectLocation
+		} else {
+			left = append(left, c)
+		}
+	}
+
+	for i := 0; i < len(answer); i++ {
+		r := result[i]
+
+		if r == CharacterStatusCorrectLocation {
+			continue
+		}
+
+		a := answer[i]
+
+		if l.contains(left, a) {
+			result[i] = CharacterStatusWrongLocation
+
+			left = l.removeRune(left, a)
+		} else {
+			result[i] = CharacterStatusNotPresent
+		}
+	}
+
+	return result
+}
+
+func (l *Language) removeRune(runes []rune, r rune) []rune {
+	for i := 0; i < len(runes); i++ {
+		if l.ToUpper(runes[i]) == l.ToUpper(r) {
+			return append(runes[:i], runes[i+1:]...)
+		}
+	}
+
+	return runes
+}
@@ -0,0 +1,118 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestCheckAnswerRunes(t *testing.T) {
+	testCases := []struct {
+		answer   []rune
+		correct  []rune
+		expected []core.CharacterStatus
+	}{
+		{
+			answer:  []rune{'A', 'A', 'B', 'C', 'D'},
+			correct: []rune{'A', 'F', 'G', 'B', 'B'},
+			expected: []core.CharacterStatus{
+				core.CharacterStatusCorrectLocation,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusWrongLocation,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusNotPresent,
+			},
+		},
+		{
+			answer:  []rune{'A', 'A', 'C', 'B', 'B'},
+			correct: []rune{'B', 'C', 'A', 'B', 'B'},
+			expected: []core.CharacterStatus{
+				core.CharacterStatusWrongLocation,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusWrongLocation,
+				core.CharacterStatusCorrectLocation,
+				core.CharacterStatusCorrectLocation,
+			},
+		},
+		{
+			answer:  []rune{'A', 'B', 'C', 'D', 'E'},
+			correct: []rune{'F', 'G', 'H', 'I', 'İ'},
+			expected: []core.CharacterStatus{
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusNotPresent,
+			},
+		},
+		{
+			answer:  []rune{'A', 'B', 'C', 'D', 'E'},
+			correct: []rune{'E', 'D', 'C', 'B', 'A'},
+			expected: []core.CharacterStatus{
+				core.CharacterStatusWrongLocation,
+				core.CharacterStatusWrongLocation,
+				core.CharacterStatusCorrectLocation,
+				core.CharacterStatusWrongLocation,
+				core.CharacterStatusWrongLocation,
+			},
+		},
+		{
+			answer:  []rune{'A', 'A', 'A', 'A', 'A'},
+			correct: []rune{'A', 'B', 'C', 'A', 'A'},
+			expected: []core.CharacterStatus{
+				core.CharacterStatusCorrectLocation,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusNotPresent,
+				core.CharacterStatusCorrectLocation,
+				core.CharacterStatusCorrectLocation,
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+		tc := testCases[i]
+
+		t.Run(fmt.Sprintf("Answer: %c, Correct: %c", tc.answer, tc.correct), func(t *testing.T) {
+			actual := core.Turkish.CheckAnswerRunes(tc.answer, tc.correct)
+			if !assertAreEqual(tc.expected, actual) {
+				t.Errorf("\nExpected: %v\n Actual: %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckAnswerRunesCasing(t *testing.T) {
+	testCases := []struct {
+		language *core.Language
+		answer   string
+		correct  string
+		expected core.CharacterStatus
+	}{
+		{core.English, "i", "I", core.CharacterStatusCorrectLocation},
+		{core.English, "i", "İ", core.CharacterStatusNotPresent},
+		{core.Turkish, "i", "İ", core.CharacterStatusCorrectLocation},
+		{core.Turkish, "ı", "I", core.CharacterStatusCorrectLocation},
+		{core.Turkish, "i", "I", core.CharacterStatusNotPresent},
+	}
+
+	for _, tc := range testCases {
+		if actual := tc.language.CheckAnswerRunes([]rune(tc.answer), []rune(tc.correct)); actual[0] != tc.expected {
+			t.Errorf("%s: %s for %s expected=%v actual=%v", tc.language.Name, tc.answer, tc.correct, tc.expected, actual[0])
+		}
+	}
+}
+
+func assertAreEqual(v1, v2 []core.CharacterStatus) bool {
+	if len(v1) != len(v2) {
+		return false
+	}
+
+	for i := 0; i < len(v1); i++ {
+		if v1[i] != v2[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,33 @@
+package core
+
+import (
+	"math/rand"
+	"time"
+)
+
+// dailySeed fixes the order of the daily answers, changing it changes every daily puzzle
+const dailySeed = 20250101
+
+// dailyEpoch is the local date of the first daily puzzle
+var dailyEpoch = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// DailyPuzzleNumber returns the number of the daily puzzle on the local date of t, the first puzzle is number 1
+func DailyPuzzleNumber(t time.Time) int {
+	y, m, d := t.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	return int(date.Sub(dailyEpoch).Hours()/24) + 1
+}
+
+// DailyWord returns the answer of the daily puzzle with the given number.
+// The targets are shuffled with a fixed seed so the answers do not follow the alphabetical order of the list.
+// The answers are picked by position, adding or removing a target changes the puzzles of every day.
+func (d *Dictionary) DailyWord(number int) string {
+	order := rand.New(rand.NewSource(dailySeed)).Perm(len(d.targets))
+	i := (number - 1) % len(order)
+	if i < 0 {
+		i += len(order)
+	}
+
+	return d.targets[order[i]]
+}
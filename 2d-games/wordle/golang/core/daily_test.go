@@ -0,0 +1,66 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestDailyPuzzleNumber(t *testing.T) {
+	istanbul := time.FixedZone("TRT", 3*60*60)
+
+	testCases := []struct {
+		time     time.Time
+		expected int
+	}{
+		{time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), 1},
+		{time.Date(2025, time.January, 1, 23, 59, 0, 0, time.UTC), 1},
+		{time.Date(2025, time.January, 2, 0, 30, 0, 0, istanbul), 2},
+		{time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC), 366},
+	}
+
+	for _, tc := range testCases {
+		if actual := core.DailyPuzzleNumber(tc.time); actual != tc.expected {
+			t.Errorf("DailyPuzzleNumber(%v) expected=%d actual=%d", tc.time, tc.expected, actual)
+		}
+	}
+}
+
+func TestDailyWord(t *testing.T) {
+	d := core.NewDictionary()
+	if d.DailyWord(100) != core.NewDictionary().DailyWord(100) {
+		t.Error("daily word should be the same for the same puzzle")
+	}
+
+	different := false
+	for n := 2; n < 10; n++ {
+		if d.DailyWord(n) != d.DailyWord(1) {
+			different = true
+		}
+	}
+
+	if !different {
+		t.Error("daily words should change every day")
+	}
+}
+
+// TestDailyWordAnswers pins some Turkish daily answers, an edit of the targets which changes them has to say so
+func TestDailyWordAnswers(t *testing.T) {
+	testCases := []struct {
+		number   int
+		expected string
+	}{
+		{1, "KENAR"},
+		{161, "MEKAN"},
+		{365, "ALBÜM"},
+		{657, "DENEY"},
+	}
+
+	d := core.NewDictionary()
+	for _, tc := range testCases {
+		if actual := d.DailyWord(tc.number); actual != tc.expected {
+			t.Errorf("DailyWord(%d) expected=%s actual=%s", tc.number, tc.expected, actual)
+		}
+	}
+}
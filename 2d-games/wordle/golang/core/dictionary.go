@@ -0,0 +1,101 @@
+package core
+
+import (
+	_ "embed"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MinWordLength = 4
+	MaxWordLength = 8
+)
+
+//go:embed dict.txt
+var dictTxt string
+
+//go:embed target.txt
+var targetTxt string
+
+type Dictionary struct {
+	Words      []string
+	targets    []string
+	language   *Language
+	wordLength int
+}
+
+// NewDictionary returns the default dictionary, the built in Turkish 5 letter words
+func NewDictionary() *Dictionary {
+	d, _ := NewLanguageDictionary(Turkish, 5)
+
+	return d
+}
+
+// NewLanguageDictionary returns the words of the language with the given length
+func NewLanguageDictionary(language *Language, wordLength int) (*Dictionary, error) {
+	d := &Dictionary{language: language, wordLength: wordLength}
+
+	d.init()
+
+	if len(d.Words) == 0 || len(d.targets) == 0 {
+		return nil, fmt.Errorf("there are no %d letter words in language '%s'", wordLength, language.Name)
+	}
+
+	return d, nil
+}
+
+func (d *Dictionary) init() {
+	d.Words = loadWords(d.language.dict.Text, d.language, d.wordLength)
+	d.targets = loadWords(d.language.targets.Text, d.language, d.wordLength)
+}
+
+// Language returns the language of the words
+func (d *Dictionary) Language() *Language {
+	return d.language
+}
+
+// Targets returns the words which can be chosen as the answer
+func (d *Dictionary) Targets() []string {
+	return d.targets
+}
+
+func (d *Dictionary) GetRandomWord() string {
+	return d.targets[rand.Intn(len(d.targets))]
+}
+
+func (d *Dictionary) WordExists(w string) bool {
+	wUpper := d.language.Upper(w)
+
+	i := sort.SearchStrings(d.Words, wUpper)
+	return i < len(d.Words) && d.Words[i] == wUpper
+}
+
+func loadWords(target string, language *Language, wordLength int) []string {
+	w := filterLength(strings.Split(strings.ReplaceAll(target, "\r", ""), "\n"), wordLength)
+	toUpper(&w, language)
+	sort.Strings(w)
+
+	return w
+}
+
+func toUpper(arr *[]string, language *Language) {
+	l := len(*arr)
+
+	for i := 0; i < l; i++ {
+		(*arr)[i] = language.Upper((*arr)[i])
+	}
+}
+
+func filterLength(words []string, length int) []string {
+	result := words[:0]
+	for _, w := range words {
+		if utf8.RuneCountInString(w) == length {
+			result = append(result, w)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package core_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestNewDictionary(t *testing.T) {
+	d := core.NewDictionary()
+
+	if len(d.Words) == 0 {
+		t.Error("dictionary word count should not be zero")
+	}
+
+	for i := 0; i < len(d.Words); i++ {
+		if d.Words[i] != core.TurkishUpper.String(d.Words[i]) {
+			t.Errorf("dictionary word %s is not upper-case", d.Words[i])
+		}
+	}
+
+	if !sort.StringsAreSorted(d.Words) {
+		t.Error("dictionary words are not sorted")
+	}
+}
+
+func TestGetRandomWord(t *testing.T) {
+	d := core.NewDictionary()
+	w := d.GetRandomWord()
+
+	if w == "" {
+		t.Error("dictionary random word cannot be empty")
+	}
+}
+
+func TestWordExists(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"", false},
+		{"apple", false},
+		{"APPLE", false},
+		{"ayakkabı", false},
+		{"ayakkabı", false},
+		{"Arşiv", true},
+		{"arşiv", true},
+		{"ARŞİV", true},
+		{"ArŞiv", true},
+	}
+
+	d := core.NewDictionary()
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("dictionary exists %s", tc.input), func(t *testing.T) {
+			actual := d.WordExists(tc.input)
+			if actual != tc.expected {
+				t.Errorf("wordExists('%s') expected=%v actual=%v", tc.input, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEnglishCommonWordsExist(t *testing.T) {
+	words := map[int][]string{
+		4: {"word", "game", "play", "hand", "tree", "blue"},
+		5: {"house", "money", "stare", "adieu", "crane", "slate", "audio", "raise", "white", "world"},
+		6: {"garden", "market", "yellow", "button", "people"},
+		7: {"example", "kitchen", "morning", "evening", "because"},
+		8: {"computer", "elephant", "mountain", "language", "question"},
+	}
+
+	for length, list := range words {
+		d, err := core.NewLanguageDictionary(core.English, length)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, w := range list {
+			if !d.WordExists(w) {
+				t.Errorf("%s should be an allowed guess", w)
+			}
+		}
+	}
+}
+
+func TestNewLanguageDictionaryWordLength(t *testing.T) {
+	for length := core.MinWordLength; length <= core.MaxWordLength; length++ {
+		d, err := core.NewLanguageDictionary(core.English, length)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, w := range d.Words {
+			if l := len([]rune(w)); l != length {
+				t.Fatalf("word %s has %d letters, expected %d", w, l, length)
+			}
+		}
+
+		if l := len([]rune(d.GetRandomWord())); l != length {
+			t.Errorf("random word has %d letters, expected %d", l, length)
+		}
+	}
+
+	if _, err := core.NewLanguageDictionary(core.Turkish, 7); err == nil {
+		t.Error("expected an error for a length without words")
+	}
+}
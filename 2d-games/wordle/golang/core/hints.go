@@ -0,0 +1,67 @@
+package core
+
+// Hints are the letters revealed by the scored guesses, in hard mode every guess has to use them
+type Hints struct {
+	// Correct holds the letters known at each position, 0 where the letter is unknown
+	Correct []rune
+	// Present holds the minimum number of times each revealed letter occurs in the answer
+	Present map[rune]int
+	// order keeps the present letters in the order they were revealed so violations are reported consistently
+	order []rune
+}
+
+// HintViolation is the first hint a guess does not use. Position is -1 when a letter is missing from the guess.
+type HintViolation struct {
+	Position int
+	Letter   rune
+}
+
+func NewHints(length int) *Hints {
+	return &Hints{
+		Correct: make([]rune, length),
+		Present: map[rune]int{},
+	}
+}
+
+func (h *Hints) Add(guess []rune, result []CharacterStatus) {
+	counts := map[rune]int{}
+	for i, s := range result {
+		switch s {
+		case CharacterStatusCorrectLocation:
+			h.Correct[i] = guess[i]
+			counts[guess[i]]++
+		case CharacterStatusWrongLocation:
+			counts[guess[i]]++
+		}
+	}
+
+	for _, r := range guess {
+		if counts[r] > h.Present[r] {
+			if h.Present[r] == 0 {
+				h.order = append(h.order, r)
+			}
+			h.Present[r] = counts[r]
+		}
+	}
+}
+
+func (h *Hints) Violation(guess []rune) (HintViolation, bool) {
+	for i, r := range h.Correct {
+		if r != 0 && guess[i] != r {
+			return HintViolation{Position: i, Letter: r}, true
+		}
+	}
+
+	counts := map[rune]int{}
+	for _, r := range guess {
+		counts[r]++
+	}
+
+	for _, r := range h.order {
+		if counts[r] < h.Present[r] {
+			return HintViolation{Position: -1, Letter: r}, true
+		}
+	}
+
+	return HintViolation{}, false
+}
@@ -0,0 +1,61 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestHintsViolation(t *testing.T) {
+	answer := []rune("KAŞIK")
+	hints := core.NewHints(5)
+	for _, g := range []string{"ŞAPKA", "KİRLİ"} {
+		guess := []rune(g)
+		hints.Add(guess, core.Turkish.CheckAnswerRunes(guess, answer))
+	}
+
+	testCases := []struct {
+		guess    string
+		expected core.HintViolation
+		violates bool
+	}{
+		{"KAŞIK", core.HintViolation{}, false},
+		{"KASAP", core.HintViolation{Position: -1, Letter: 'Ş'}, true},
+		{"BAŞAK", core.HintViolation{Position: 0, Letter: 'K'}, true},
+		{"KOŞUL", core.HintViolation{Position: 1, Letter: 'A'}, true},
+		{"KAŞAR", core.HintViolation{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.guess, func(t *testing.T) {
+			v, violates := hints.Violation([]rune(tc.guess))
+			if violates != tc.violates || v != tc.expected {
+				t.Errorf("expected=%v %v actual=%v %v", tc.expected, tc.violates, v, violates)
+			}
+		})
+	}
+}
+
+func TestHintsRepeatedLetters(t *testing.T) {
+	answer := []rune("LEVEL")
+	guess := []rune("EERIE")
+	hints := core.NewHints(5)
+	hints.Add(guess, core.Turkish.CheckAnswerRunes(guess, answer))
+
+	if hints.Present['E'] != 2 {
+		t.Fatalf("expected E to be present twice, actual %d", hints.Present['E'])
+	}
+
+	v, violates := hints.Violation([]rune("HEAVY"))
+	if !violates || v.Letter != 'E' {
+		t.Errorf("expected a missing E, actual %v %v", v, violates)
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	for n, expected := range map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 21: "21st"} {
+		if actual := core.English.Ordinal(n); actual != expected {
+			t.Errorf("%d expected=%s actual=%s", n, expected, actual)
+		}
+	}
+}
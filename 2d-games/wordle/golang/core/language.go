@@ -0,0 +1,233 @@
+package core
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+var (
+	TurkishUpper = cases.Upper(language.Turkish)
+)
+
+const (
+	LanguageEnglish = "en"
+	LanguageTurkish = "tr"
+)
+
+type MessageKey int
+
+const (
+	MessageTitle MessageKey = iota
+	MessageNotInWordList
+	MessageYouWon
+	MessageLetterMustBe
+	MessageGuessMustContain
+	MessageStatistics
+	MessagePlayed
+	MessageWinPercentage
+	MessageCurrentStreak
+	MessageMaxStreak
+	MessageGuessDistribution
+	MessageCopied
+	MessageSavedTo
+	MessageHint
+	MessageSolverFound
+	MessageSolverFailed
+	MessageAnswer
+	MessageNewGame
+	MessageWordLength
+	MessageScore
+	MessageRunDetail
+	MessageOtherSave
+)
+
+//go:embed dict_en.txt
+var dictEnTxt string
+
+//go:embed target_en.txt
+var targetEnTxt string
+
+// Language bundles everything that differs between the languages the game can be played in
+type Language struct {
+	Name         string
+	Alphabet     string
+	KeyboardRows []string
+	Messages     map[MessageKey]string
+	// Ordinal formats a 1 based position like 2nd
+	Ordinal func(n int) string
+
+	special unicode.SpecialCase
+	upper   cases.Caser
+	dict    WordList
+	targets WordList
+}
+
+var (
+	English = &Language{
+		Name:         LanguageEnglish,
+		Alphabet:     "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		KeyboardRows: []string{"QWERTYUIOP", "ASDFGHJKL", "ZXCVBNM"},
+		Messages: map[MessageKey]string{
+			MessageTitle:             "Wordle",
+			MessageNotInWordList:     "Not in Word List!!",
+			MessageYouWon:            "You Won!!",
+			MessageLetterMustBe:      "%s letter must be %c",
+			MessageGuessMustContain:  "Guess must contain %c",
+			MessageStatistics:        "STATISTICS",
+			MessagePlayed:            "Played",
+			MessageWinPercentage:     "Win %",
+			MessageCurrentStreak:     "Current Streak",
+			MessageMaxStreak:         "Max Streak",
+			MessageGuessDistribution: "GUESS DISTRIBUTION",
+			MessageCopied:            "Copied to Clipboard",
+			MessageSavedTo:           "Saved to %s",
+			MessageHint:              "Hint: %s (%d words left)",
+			MessageSolverFound:       "A Solver Needs %d Guesses",
+			MessageSolverFailed:      "A Solver Could Not Find It",
+			MessageAnswer:            "Answer: %s",
+			MessageNewGame:           "NEW GAME",
+			MessageWordLength:        "Word must have %d letters",
+			MessageScore:             "Score: %d",
+			MessageRunDetail:         "%d words, best score %d",
+			MessageOtherSave:         "Saved game has other settings",
+		},
+		Ordinal: func(n int) string {
+			suffix := "th"
+			if n%100 < 11 || n%100 > 13 {
+				switch n % 10 {
+				case 1:
+					suffix = "st"
+				case 2:
+					suffix = "nd"
+				case 3:
+					suffix = "rd"
+				}
+			}
+
+			return strconv.Itoa(n) + suffix
+		},
+		upper:   cases.Upper(language.English),
+		dict:    WordList{"dict_en.txt", dictEnTxt},
+		targets: WordList{"target_en.txt", targetEnTxt},
+	}
+
+	Turkish = &Language{
+		Name:         LanguageTurkish,
+		Alphabet:     "ABCÇDEFGĞHIİJKLMNOÖPRSŞTUÜVYZ",
+		KeyboardRows: []string{"ERTYUIOPĞÜ", "ASDFGHJKLŞİ", "ZCVBNMÖÇ"},
+		Messages: map[MessageKey]string{
+			MessageTitle:             "Türkçe Wordle",
+			MessageNotInWordList:     "Kelime Listesinde Yok!!",
+			MessageYouWon:            "Kazandınız!!",
+			MessageLetterMustBe:      "%s harf %c olmalı",
+			MessageGuessMustContain:  "Tahmin %c içermeli",
+			MessageStatistics:        "İSTATİSTİKLER",
+			MessagePlayed:            "Oynanan",
+			MessageWinPercentage:     "Kazanma %",
+			MessageCurrentStreak:     "Güncel Seri",
+			MessageMaxStreak:         "En Uzun Seri",
+			MessageGuessDistribution: "TAHMİN DAĞILIMI",
+			MessageCopied:            "Panoya Kopyalandı",
+			MessageSavedTo:           "%s dosyasına kaydedildi",
+			MessageHint:              "İpucu: %s (%d olası kelime)",
+			MessageSolverFound:       "Çözücü %d Tahminde Bulurdu",
+			MessageSolverFailed:      "Çözücü Bulamazdı",
+			MessageAnswer:            "Cevap: %s",
+			MessageNewGame:           "YENİ OYUN",
+			MessageWordLength:        "Kelime %d harfli olmalı",
+			MessageScore:             "Puan: %d",
+			MessageRunDetail:         "%d kelime, en yüksek puan %d",
+			MessageOtherSave:         "Kayıtlı oyunun ayarları farklı",
+		},
+		Ordinal: func(n int) string {
+			return strconv.Itoa(n) + "."
+		},
+		special: unicode.TurkishCase,
+		upper:   TurkishUpper,
+		dict:    WordList{dictFileName, dictTxt},
+		targets: WordList{targetsFileName, targetTxt},
+	}
+
+	languages = map[string]*Language{
+		LanguageEnglish: English,
+		LanguageTurkish: Turkish,
+	}
+)
+
+func GetLanguage(name string) (*Language, error) {
+	l, ok := languages[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown language '%s', available languages: %s", name, strings.Join(LanguageNames(), ", "))
+	}
+
+	return l, nil
+}
+
+// RegisterLanguage makes the language available with its name, a registered language with the same name is replaced
+func RegisterLanguage(l *Language) {
+	languages[l.Name] = l
+}
+
+func LanguageNames() []string {
+	names := make([]string, 0, len(languages))
+	for name := range languages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Next returns the language after l in name order, it is used to switch languages in game
+func (l *Language) Next() *Language {
+	names := LanguageNames()
+	i := sort.SearchStrings(names, l.Name)
+
+	return languages[names[(i+1)%len(names)]]
+}
+
+func (l *Language) ToUpper(r rune) rune {
+	if l.special != nil {
+		return l.special.ToUpper(r)
+	}
+
+	return unicode.ToUpper(r)
+}
+
+func (l *Language) Upper(s string) string {
+	return l.upper.String(s)
+}
+
+// HasLetter reports whether r is a letter of the alphabet in any case
+func (l *Language) HasLetter(r rune) bool {
+	return strings.ContainsRune(l.Alphabet, l.ToUpper(r))
+}
+
+func (l *Language) Message(key MessageKey) string {
+	return l.Messages[key]
+}
+
+// HintMessage explains which hint a hard mode guess does not use
+func (l *Language) HintMessage(v HintViolation) string {
+	if v.Position < 0 {
+		return fmt.Sprintf(l.Message(MessageGuessMustContain), v.Letter)
+	}
+
+	return fmt.Sprintf(l.Message(MessageLetterMustBe), l.Ordinal(v.Position+1), v.Letter)
+}
+
+func (l *Language) contains(runes []rune, r rune) bool {
+	for _, v := range runes {
+		if l.ToUpper(v) == l.ToUpper(r) {
+			return true
+		}
+	}
+	return false
+}
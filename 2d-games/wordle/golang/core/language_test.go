@@ -0,0 +1,71 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestLanguageKeyboardRows(t *testing.T) {
+	for _, name := range core.LanguageNames() {
+		l, err := core.GetLanguage(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		keys := 0
+		for _, row := range l.KeyboardRows {
+			for _, r := range row {
+				keys++
+				if !l.HasLetter(r) {
+					t.Errorf("%s: keyboard key '%c' is not in the alphabet", name, r)
+				}
+			}
+		}
+
+		if keys != len([]rune(l.Alphabet)) {
+			t.Errorf("%s: keyboard has %d keys, alphabet has %d letters", name, keys, len([]rune(l.Alphabet)))
+		}
+	}
+}
+
+func TestLanguageCasing(t *testing.T) {
+	testCases := []struct {
+		language string
+		input    string
+		expected string
+		exists   bool
+	}{
+		{core.LanguageEnglish, "light", "LIGHT", true},
+		{core.LanguageEnglish, "Crane", "CRANE", true},
+		{core.LanguageEnglish, "arşiv", "ARŞIV", false},
+		{core.LanguageTurkish, "arşiv", "ARŞİV", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %s", tc.language, tc.input), func(t *testing.T) {
+			l, err := core.GetLanguage(tc.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual := l.Upper(tc.input); actual != tc.expected {
+				t.Errorf("Upper(%q) expected=%s actual=%s", tc.input, tc.expected, actual)
+			}
+
+			d, err := core.NewLanguageDictionary(l, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual := d.WordExists(tc.input); actual != tc.exists {
+				t.Errorf("WordExists(%q) expected=%v actual=%v", tc.input, tc.exists, actual)
+			}
+		})
+	}
+
+	if _, err := core.GetLanguage("xx"); err == nil {
+		t.Error("expected an error for an unknown language")
+	}
+}
@@ -0,0 +1,53 @@
+package core
+
+import "fmt"
+
+type CharacterStatus int
+
+const (
+	CharacterStatusNone CharacterStatus = iota
+	CharacterStatusNotPresent
+	CharacterStatusWrongLocation
+	CharacterStatusCorrectLocation
+)
+
+func (s CharacterStatus) String() string {
+	switch s {
+	case CharacterStatusWrongLocation:
+		return "CharacterStatusWrongLocation"
+	case CharacterStatusNotPresent:
+		return "CharacterStatusNotPresent"
+	case CharacterStatusCorrectLocation:
+		return "CharacterStatusCorrectLocation"
+	default:
+		return "unknown"
+	}
+}
+
+// statusNames are the names of the statuses in the JSON of the game server
+var statusNames = map[CharacterStatus]string{
+	CharacterStatusNone:            "none",
+	CharacterStatusNotPresent:      "absent",
+	CharacterStatusWrongLocation:   "present",
+	CharacterStatusCorrectLocation: "correct",
+}
+
+func (s CharacterStatus) MarshalText() ([]byte, error) {
+	name, ok := statusNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown character status %d", int(s))
+	}
+
+	return []byte(name), nil
+}
+
+func (s *CharacterStatus) UnmarshalText(text []byte) error {
+	for status, name := range statusNames {
+		if name == string(text) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown character status '%s'", text)
+}
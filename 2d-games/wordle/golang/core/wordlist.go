@@ -0,0 +1,220 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	dictFileName    = "dict.txt"
+	targetsFileName = "target.txt"
+)
+
+// WordList is the text of a word list file with a word in every line, Name is used in the problem reports
+type WordList struct {
+	Name string
+	Text string
+}
+
+// Problem is a line of a word list which cannot be used
+type Problem struct {
+	Name    string
+	Line    int
+	Message string
+}
+
+func (p Problem) Error() string {
+	return fmt.Sprintf("%s:%d: %s", p.Name, p.Line, p.Message)
+}
+
+// ReadWordList reads the word list file at path
+func ReadWordList(path string) (WordList, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return WordList{}, err
+	}
+
+	return WordList{Name: path, Text: string(b)}, nil
+}
+
+// lines returns the lines of the list, the new line at the end of the file does not start a blank line
+func (w WordList) lines() []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(w.Text, "\r", ""), "\n"), "\n")
+}
+
+// LintWordLists checks every line of the lists for blank lines, word lengths, letters outside the alphabet
+// and duplicates, and that every target is in the dictionary
+func LintWordLists(language *Language, dict, targets WordList) []Problem {
+	problems, words := lintWordList(language, dict)
+	targetProblems, targetWords := lintWordList(language, targets)
+	problems = append(problems, targetProblems...)
+
+	inDict := make(map[string]bool, len(words))
+	for _, w := range words {
+		inDict[w] = true
+	}
+
+	for i, w := range targetWords {
+		if w != "" && !inDict[w] {
+			problems = append(problems, Problem{targets.Name, i + 1, fmt.Sprintf("target '%s' is not in %s", w, dict.Name)})
+		}
+	}
+
+	return problems
+}
+
+// lintWordList returns the problems of the list and the upper-case word of every line, blank lines are empty
+func lintWordList(language *Language, list WordList) ([]Problem, []string) {
+	var problems []Problem
+	report := func(line int, format string, a ...any) {
+		problems = append(problems, Problem{list.Name, line, fmt.Sprintf(format, a...)})
+	}
+
+	lines := list.lines()
+	first := make(map[string]int, len(lines))
+	upper := make([]string, len(lines))
+	for i, w := range lines {
+		line := i + 1
+		if strings.TrimSpace(w) == "" {
+			report(line, "blank line")
+			continue
+		}
+
+		if l := utf8.RuneCountInString(w); l < MinWordLength || l > MaxWordLength {
+			report(line, "'%s' has %d letters, words must have %d-%d letters", w, l, MinWordLength, MaxWordLength)
+		}
+
+		for _, r := range w {
+			if !language.HasLetter(r) {
+				report(line, "'%s' has '%c' which is not in the %s alphabet", w, r, language.Name)
+				break
+			}
+		}
+
+		upper[i] = language.Upper(w)
+		if l, ok := first[upper[i]]; ok {
+			report(line, "'%s' is a duplicate of line %d", w, l)
+			continue
+		}
+		first[upper[i]] = line
+	}
+
+	return problems, upper
+}
+
+// WithWordLists returns a copy of the language playing the given lists, the lists are not used when they have problems
+func (l *Language) WithWordLists(dict, targets WordList) (*Language, error) {
+	if problems := LintWordLists(l, dict, targets); len(problems) > 0 {
+		errs := make([]error, len(problems))
+		for i, p := range problems {
+			errs[i] = p
+		}
+
+		return nil, errors.Join(errs...)
+	}
+
+	c := *l
+	c.dict, c.targets = dict, targets
+
+	return &c, nil
+}
+
+// WordLists returns the lists the language is played with
+func (l *Language) WordLists() (dict, targets WordList) {
+	return l.dict, l.targets
+}
+
+// LoadWordLists returns a copy of the language playing the word list files, an empty path keeps the current list
+func (l *Language) LoadWordLists(dictPath, targetsPath string) (*Language, error) {
+	dict, targets := l.WordLists()
+
+	var err error
+	if dictPath != "" {
+		if dict, err = ReadWordList(dictPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if targetsPath != "" {
+		if targets, err = ReadWordList(targetsPath); err != nil {
+			return nil, err
+		}
+	}
+
+	return l.WithWordLists(dict, targets)
+}
+
+// ReadLanguagePack reads the lists of the language pack in dir, like dir/en/dict.txt and dir/en/target.txt.
+// A pack without one of the files keeps the current list of the language.
+func ReadLanguagePack(dir string, l *Language) (WordList, WordList, error) {
+	dict, targets := l.WordLists()
+
+	dict, err := readPackFile(filepath.Join(dir, l.Name, dictFileName), dict)
+	if err != nil {
+		return dict, targets, err
+	}
+
+	targets, err = readPackFile(filepath.Join(dir, l.Name, targetsFileName), targets)
+
+	return dict, targets, err
+}
+
+// LoadLanguagePacks replaces the word lists of the languages which have a folder in dir, see ReadLanguagePack
+func LoadLanguagePacks(dir string) error {
+	languages, err := PackLanguages(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range languages {
+		dict, targets, err := ReadLanguagePack(dir, l)
+		if err != nil {
+			return err
+		}
+
+		if l, err = l.WithWordLists(dict, targets); err != nil {
+			return err
+		}
+
+		RegisterLanguage(l)
+	}
+
+	return nil
+}
+
+// PackLanguages returns the languages of the language packs in dir
+func PackLanguages(dir string) ([]*Language, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Language
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		l, err := GetLanguage(e.Name())
+		if err != nil {
+			return nil, fmt.Errorf("language pack %s: %w", filepath.Join(dir, e.Name()), err)
+		}
+
+		result = append(result, l)
+	}
+
+	return result, nil
+}
+
+func readPackFile(path string, current WordList) (WordList, error) {
+	w, err := ReadWordList(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return current, nil
+	}
+
+	return w, err
+}
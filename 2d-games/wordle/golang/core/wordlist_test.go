@@ -0,0 +1,98 @@
+package core_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+func TestBuiltInWordLists(t *testing.T) {
+	for _, l := range []*core.Language{core.English, core.Turkish} {
+		dict, targets := l.WordLists()
+		for _, p := range core.LintWordLists(l, dict, targets) {
+			t.Error(p)
+		}
+	}
+}
+
+func TestLintWordLists(t *testing.T) {
+	dict := core.WordList{Name: "dict.txt", Text: "kalem\r\nKALEM\n\nmekân\nkitaplık\nev\nsözlükler\nsalon\n"}
+	targets := core.WordList{Name: "target.txt", Text: "salon\nmasal"}
+
+	expected := []core.Problem{
+		{Name: "dict.txt", Line: 2, Message: "'KALEM' is a duplicate of line 1"},
+		{Name: "dict.txt", Line: 3, Message: "blank line"},
+		{Name: "dict.txt", Line: 4, Message: "'mekân' has 'â' which is not in the tr alphabet"},
+		{Name: "dict.txt", Line: 6, Message: "'ev' has 2 letters, words must have 4-8 letters"},
+		{Name: "dict.txt", Line: 7, Message: "'sözlükler' has 9 letters, words must have 4-8 letters"},
+		{Name: "target.txt", Line: 2, Message: "target 'MASAL' is not in dict.txt"},
+	}
+
+	actual := core.LintWordLists(core.Turkish, dict, targets)
+	if !slices.Equal(actual, expected) {
+		t.Errorf("\nexpected=%v\nactual=  %v", expected, actual)
+	}
+}
+
+func TestWithWordLists(t *testing.T) {
+	dict := core.WordList{Name: "dict.txt", Text: "apple\nbread\nchair\n"}
+	targets := core.WordList{Name: "target.txt", Text: "bread\n"}
+
+	l, err := core.English.WithWordLists(dict, targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := core.NewLanguageDictionary(l, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(d.Words, []string{"APPLE", "BREAD", "CHAIR"}) || !slices.Equal(d.Targets(), []string{"BREAD"}) {
+		t.Errorf("unexpected words %v targets %v", d.Words, d.Targets())
+	}
+
+	if builtIn, _ := core.English.WordLists(); builtIn.Name != "dict_en.txt" {
+		t.Errorf("the built-in lists should not change, got %s", builtIn.Name)
+	}
+
+	if _, err := core.English.WithWordLists(dict, core.WordList{Name: "target.txt", Text: "zebra\n"}); err == nil {
+		t.Error("expected an error for a target which is not in the dictionary")
+	}
+}
+
+func TestLoadLanguagePacks(t *testing.T) {
+	t.Cleanup(func() {
+		core.RegisterLanguage(core.English)
+	})
+
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "en"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "en", "target.txt"), []byte("about\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := core.LoadLanguagePacks(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	l, _ := core.GetLanguage("en")
+	dict, targets := l.WordLists()
+	if dict.Name != "dict_en.txt" || targets.Name != filepath.Join(dir, "en", "target.txt") {
+		t.Errorf("unexpected lists %s %s", dict.Name, targets.Name)
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "xx"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := core.LoadLanguagePacks(dir); err == nil {
+		t.Error("expected an error for an unknown language")
+	}
+}
@@ -0,0 +1,56 @@
+package wordle
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/solver"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const analysisMaxTileSize = 40
+
+// analysisScreen shows the guesses the solver would have made in the finished game
+type analysisScreen struct {
+	title  *TextRenderer
+	letter *TextRenderer
+}
+
+func newAnalysisScreen() *analysisScreen {
+	return &analysisScreen{
+		title:  NewTextRenderer(RobotoBoldFontName, color.Black, 22),
+		letter: NewTextRenderer(RobotoBoldFontName, color.White, 20),
+	}
+}
+
+func (s *analysisScreen) draw(screen *ebiten.Image, guesses []solver.Guess, language *core.Language) {
+	w := float32(screen.Bounds().Dx())
+	vector.DrawFilledRect(screen, 0, 0, w, float32(screen.Bounds().Dy()), color.RGBA{A: 128}, false)
+	vector.DrawFilledRect(screen, 20, 60, w-40, 480, color.White, false)
+
+	title := language.Message(core.MessageSolverFailed)
+	if len(guesses) > 0 && guesses[len(guesses)-1].Solved() {
+		title = fmt.Sprintf(language.Message(core.MessageSolverFound), len(guesses))
+	}
+	s.title.Draw(screen, title, int(w)/2, 90)
+
+	if len(guesses) == 0 {
+		return
+	}
+
+	cols := len([]rune(guesses[0].Word))
+	top := 130
+	size := min(analysisMaxTileSize, (520-top)/len(guesses)-tileGap, (int(w)-60)/cols-tileGap)
+	left := (int(w) - (cols*(size+tileGap) - tileGap)) / 2
+
+	for row, g := range guesses {
+		y := top + row*(size+tileGap)
+		for col, r := range []rune(g.Word) {
+			x := left + col*(size+tileGap)
+			vector.DrawFilledRect(screen, float32(x), float32(y), float32(size), float32(size), statusColor(g.Result[col]), false)
+			s.letter.Draw(screen, string(r), x+size/2, y+size/2)
+		}
+	}
+}
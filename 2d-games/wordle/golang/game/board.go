@@ -1,65 +1,70 @@
 package wordle
 
 import (
-	"strings"
-	"unicode"
+	"image"
 
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/solver"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
-type gameState int
-
 const (
-	gameInProgress gameState = iota
-	gameLost
-	gameWon
+	boardWidth  = 360
+	boardHeight = 378
+	maxTileSize = 60
+	tileGap     = 3
 )
 
+// board draws the tiles of a core.Board and takes the input, the rules of the game are in the core.Board
 type board struct {
-	rows         int
-	cols         int
-	alphabet     string
-	dict         *dictionary
-	answer       []rune
-	tiles        []*tile
-	pos          int
-	wordNotFound bool
-	keyboard     *keyboard
-	state        gameState
-	inputRune    rune
-	inputKey     ebiten.Key
-	maxY         float64
+	rows     int
+	cols     int
+	mode     string
+	hardMode bool
+	language *core.Language
+	dict     *core.Dictionary
+	rules    *core.Board
+	tiles    []*tile
+	pos      int
+	maxY     float64
+	// rect is the area of the screen the board is laid out in
+	rect     image.Rectangle
+	tileSize int
+	tileX    float64
+	tileY    float64
 
 	tileWinAnimationFinishedCounter int
 }
 
-func newBoard(keyboard *keyboard) *board {
+// newBoard creates a board playing a random answer of the dictionary, the game feeds the input to the board
+func newBoard(dict *core.Dictionary, settings Settings, rect image.Rectangle) *board {
 	b := &board{
-		rows:      6,
-		cols:      5,
-		alphabet:  "ABCÇDEFGĞHIİJKLMNOÖPRSŞTUÜVYZ",
-		dict:      NewDictionary(),
-		keyboard:  keyboard,
-		inputRune: 0,
-		inputKey:  0,
+		mode:     settings.Mode,
+		hardMode: settings.HardMode,
+		rows:     settings.Guesses,
+		cols:     settings.WordLength,
+		language: settings.Language,
+		dict:     dict,
+		rect:     rect,
 	}
 
+	b.layout()
 	b.init()
 
 	return b
 }
 
-func (b *board) Update() {
-	if b.state == gameInProgress {
-		if b.inputKey == ebiten.KeyBackspace {
-			b.deleteCurrentChar()
-		} else if b.inputKey == ebiten.KeyEnter || b.inputKey == ebiten.KeyNumpadEnter {
-			b.checkCurrentWord()
-		} else if b.inputRune > 0 {
-			b.addChar(b.inputRune)
-		}
-	}
+// layout sizes the tiles so the board fits its rect, tiles are never bigger than 60px
+func (b *board) layout() {
+	w := (b.rect.Dx() - (b.cols-1)*tileGap) / b.cols
+	h := (b.rect.Dy() - (b.rows-1)*tileGap) / b.rows
 
+	b.tileSize = min(maxTileSize, w, h)
+	b.tileX = float64(b.rect.Min.X) + float64(b.rect.Dx()-(b.cols*(b.tileSize+tileGap)-tileGap))/2
+	b.tileY = float64(b.rect.Min.Y)
+}
+
+func (b *board) Update() {
 	for _, t := range b.tiles {
 		t.Update()
 	}
@@ -72,42 +77,11 @@ func (b *board) Draw(screen *ebiten.Image) {
 }
 
 func (b *board) GetCorrectAnswer() string {
-	return TurkishUpper.String(string(b.answer))
+	return b.rules.Answer()
 }
 
-func CheckAnswerRunes(answer, correct []rune) []CharacterStatus {
-	result := make([]CharacterStatus, len(answer))
-	l := []rune{}
-
-	for i := 0; i < len(answer); i++ {
-		a := answer[i]
-		c := correct[i]
-		if unicode.TurkishCase.ToUpper(a) == unicode.TurkishCase.ToUpper(c) {
-			result[i] = CharacterStatusCorrectLocation
-		} else {
-			l = append(l, c)
-		}
-	}
-
-	for i := 0; i < len(answer); i++ {
-		r := result[i]
-
-		if r == CharacterStatusCorrectLocation {
-			continue
-		}
-
-		a := answer[i]
-
-		if contains(l, a) {
-			result[i] = CharacterStatusWrongLocation
-
-			l = removeRune(l, a)
-		} else {
-			result[i] = CharacterStatusNotPresent
-		}
-	}
-
-	return result
+func (b *board) state() core.State {
+	return b.rules.State()
 }
 
 func (b *board) IsWinAnimationFinished() bool {
@@ -125,7 +99,7 @@ func calculateMaxY(tiles []*tile) float64 {
 }
 
 func (b *board) deleteCurrentChar() bool {
-	if b.pos <= 0 {
+	if b.pos <= 0 || b.state() != core.StateInProgress {
 		return false
 	}
 
@@ -133,7 +107,6 @@ func (b *board) deleteCurrentChar() bool {
 	if b.isPosInLastChar() && !t.isEmpty() {
 		if t.isCharStatusNone() {
 			t.clearRune()
-			b.wordNotFound = false
 
 			return true
 		}
@@ -141,7 +114,6 @@ func (b *board) deleteCurrentChar() bool {
 		tPrev := b.tiles[b.pos-1]
 		if tPrev.isCharStatusNone() {
 			tPrev.clearRune()
-			b.wordNotFound = false
 			b.pos--
 
 			return true
@@ -151,72 +123,104 @@ func (b *board) deleteCurrentChar() bool {
 	return false
 }
 
-func (b *board) checkCurrentWord() {
-	if !b.isPosInLastChar() {
-		return
-	}
+// validate returns the reason the current word cannot be submitted
+func (b *board) validate() error {
+	return b.rules.Validate(string(b.currentWord()))
+}
 
-	answer := make([]rune, b.cols)
+func (b *board) currentWord() []rune {
+	word := make([]rune, b.cols)
 	for i, c := b.pos-b.cols+1, 0; i < b.pos+1; i++ {
-		t := b.tiles[i]
-		answer[c] = t.r
+		word[c] = b.tiles[i].r
 		c++
 	}
 
-	if !b.dict.WordExists(string(answer)) {
-		b.wordNotFound = true
-
-		for i := b.pos - b.cols + 1; i < b.pos+1; i++ {
-			b.tiles[i].shake()
-		}
+	return word
+}
 
-		return
+// shake shakes the tiles of the current row, it is used when the current word is rejected
+func (b *board) shake() {
+	for i := b.pos - b.cols + 1; i < b.pos+1; i++ {
+		b.tiles[i].shake()
 	}
+}
 
-	won := true
-
-	checkResult := CheckAnswerRunes(answer, b.answer)
+// submit scores the word in the current row, without animate the result is shown immediately
+func (b *board) submit(animate bool) error {
+	checkResult, err := b.rules.Submit(string(b.currentWord()))
+	if err != nil {
+		return err
+	}
 
 	for i, c := b.pos-b.cols+1, 0; i < b.pos+1; i++ {
 		t := b.tiles[i]
-		s := checkResult[c]
-
-		t.setStatus(s)
-		b.keyboard.setKeyStatus(t.r, t.status)
+		t.setStatus(checkResult[c])
 
-		if s != CharacterStatusCorrectLocation {
-			won = false
+		if animate {
+			t.flip()
+		} else {
+			t.flipped = true
 		}
 
-		t.flip()
-
 		c++
 	}
 
-	if won {
-		b.state = gameWon
-
-		for i := b.pos - b.cols + 1; i < b.pos+1; i++ {
-			b.tiles[i].celebrateWin()
+	if b.state() == core.StateWon {
+		if animate {
+			for i := b.pos - b.cols + 1; i < b.pos+1; i++ {
+				b.tiles[i].celebrateWin()
+			}
+		} else {
+			b.tileWinAnimationFinishedCounter = b.cols
 		}
-	} else if b.pos >= len(b.tiles)-1 {
-		b.state = gameLost
 	}
 
 	if b.pos < len(b.tiles)-1 {
 		b.pos++
 	}
+
+	return nil
+}
+
+// restoreGuesses fills the rows with already scored guesses without animations, it stops at the first guess
+// which cannot be submitted
+func (b *board) restoreGuesses(guesses []string) {
+	for _, g := range guesses {
+		word := []rune(g)
+		if b.state() != core.StateInProgress || len(word) != b.cols {
+			return
+		}
+
+		row := b.pos / b.cols
+		for col, r := range word {
+			b.tiles[b.calcPos(col, row)].r = r
+		}
+
+		b.pos = b.calcPos(b.cols-1, row)
+		if b.submit(false) != nil {
+			for col := range word {
+				b.tiles[b.calcPos(col, row)].r = 0
+			}
+			b.pos = b.calcPos(0, row)
+
+			return
+		}
+	}
 }
 
 func (b *board) addChar(r rune) bool {
+	if b.state() != core.StateInProgress {
+		return false
+	}
+
 	if b.pos >= len(b.tiles) {
 		return false
 	}
 
 	t := b.tiles[b.pos]
 
-	if !(b.isPosInLastChar() && !t.isEmpty()) && strings.ContainsRune(b.alphabet, unicode.TurkishCase.ToUpper(r)) {
-		t.setRune(r)
+	if !(b.isPosInLastChar() && !t.isEmpty()) && b.language.HasLetter(r) {
+		t.setRune(b.language.ToUpper(r))
 
 		if !b.isPosInLastChar() {
 			b.pos++
@@ -240,11 +244,36 @@ func (b *board) init() {
 		tiles[i].clearValue()
 	}
 
-	b.state = gameInProgress
+	b.tileWinAnimationFinishedCounter = 0
 	b.pos = 0
 	b.tiles = tiles
 	b.maxY = calculateMaxY(tiles)
-	b.answer = []rune(b.dict.GetRandomWord())
+	if b.mode == ModeAbsurdle {
+		b.rules = core.NewAbsurdleBoard(b.dict, b.rows, b.hardMode)
+	} else {
+		b.rules = core.NewBoard(b.dict, b.rows, b.hardMode)
+	}
+}
+
+// reset starts a new game on the board, the tweens of the old tiles are dropped without completing them
+// so a win animation still running cannot count for the new game
+func (b *board) reset() {
+	for _, t := range b.tiles {
+		t.tween.Init()
+	}
+
+	b.init()
+}
+
+// guesses returns the scored guesses with their results for the solver
+func (b *board) guesses() []solver.Guess {
+	results := b.rules.Results()
+	guesses := make([]solver.Guess, len(results))
+	for i, w := range b.rules.Guesses() {
+		guesses[i] = solver.Guess{Word: w, Result: results[i]}
+	}
+
+	return guesses
 }
 
 func (b *board) isPosInLastChar() bool {
@@ -254,13 +283,3 @@ func (b *board) isPosInLastChar() bool {
 func (b *board) calcPos(col, row int) int {
 	return (row * b.cols) + col
 }
-
-func removeRune(l []rune, r rune) []rune {
-	for i := 0; i < len(l); i++ {
-		if unicode.TurkishCase.ToUpper(l[i]) == unicode.TurkishCase.ToUpper(r) {
-			return append(l[:i], l[i+1:]...)
-		}
-	}
-
-	return l
-}
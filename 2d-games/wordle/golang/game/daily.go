@@ -0,0 +1,9 @@
+package wordle
+
+// dailyRecord is the saved progress of the daily puzzle
+type dailyRecord struct {
+	Puzzle int `json:"puzzle"`
+	// Rows is the number of guesses the puzzle was started with
+	Rows    int      `json:"rows"`
+	Guesses []string `json:"guesses"`
+}
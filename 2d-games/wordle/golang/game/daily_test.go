@@ -0,0 +1,57 @@
+package wordle_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/game"
+)
+
+func TestDailyRestoredWithOtherGuesses(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	settings := wordle.DefaultSettings()
+	settings.Mode = wordle.ModeDaily
+	settings.Guesses = 4
+
+	g, err := wordle.NewGame(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dict, err := core.NewLanguageDictionary(settings.Language, settings.WordLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, w := range dict.Targets() {
+		if g.Finished() {
+			break
+		}
+
+		if w != g.Answer() {
+			g.Guess(w)
+		}
+	}
+
+	played, rows := g.Grid()
+	if !g.Finished() || len(played) != 4 || rows != 4 {
+		t.Fatalf("the daily puzzle should be lost after 4 guesses, played %v", played)
+	}
+
+	settings.Guesses = 8
+	g, err = wordle.NewGame(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored, rows := g.Grid()
+	if !slices.Equal(restored, played) || rows != 4 {
+		t.Errorf("expected the grid %v with 4 rows, actual %v with %d rows", played, restored, rows)
+	}
+
+	if !g.Finished() {
+		t.Error("the finished daily puzzle should not get more guesses")
+	}
+}
@@ -0,0 +1,25 @@
+package wordle
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// Guess types the word into the game and submits it like the keyboard does
+func (g *Game) Guess(word string) {
+	for _, r := range word {
+		g.handleInput(r, -1)
+	}
+	g.handleInput(0, ebiten.KeyEnter)
+}
+
+// Answer returns the answer of the first board
+func (g *Game) Answer() string {
+	return g.boards[0].rules.Answer()
+}
+
+// Grid returns the guesses on the first board and the number of rows it has
+func (g *Game) Grid() ([]string, int) {
+	return g.boards[0].rules.Guesses(), g.boards[0].rules.MaxGuesses()
+}
+
+func (g *Game) Finished() bool {
+	return g.finished()
+}
@@ -1,8 +1,15 @@
 package wordle
 
 import (
+	"fmt"
+	"image"
 	"image/color"
+	"log"
+	"strings"
+	"time"
 
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/solver"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
@@ -10,72 +17,803 @@ import (
 const (
 	ScreenWidth  = 400
 	ScreenHeight = 600
+
+	noticeTicks = 2 * 60
+
+	// multiBoardWidth and multiBoardHeight are the space of a board when several boards are played
+	multiBoardWidth  = 180
+	multiBoardHeight = 300
+	// messageHeight is the space between the boards and the keyboard for the messages
+	messageHeight = 57
 )
 
 type Game struct {
-	board    *board
+	boards   []*board
 	keyboard *keyboard
 	keys     []ebiten.Key
 	runes    []rune
 	text     *TextRenderer
+	language *core.Language
+	settings Settings
+	solver   *solver.Solver
+	message  string
+	width    int
+	height   int
+	// onGuess is called with every accepted guess
+	onGuess func(guess string)
+
+	stats       *Stats
+	statsScreen *statsScreen
+	showStats   bool
+	// statsPending opens the stats screen once the animations of the finished game are done
+	statsPending bool
+
+	analysisScreen *analysisScreen
+	// analysis holds the guesses the solver would have made, the analysis screen is shown when it is not nil
+	analysis []solver.Guess
+
+	// clock is the time of the game loop, every Update advances it by a tick
+	clock time.Duration
+	// elapsed is the playing time of the game, it runs while the game is in progress
+	elapsed time.Duration
+	// run is the state of the timed modes
+	run run
+	// resumed is true when the game was resumed from the save file
+	resumed bool
+
+	// puzzle is the number of the daily puzzle, 0 in practice mode
+	puzzle      int
+	notice      string
+	noticeTicks int
+	// pendingHint receives the hint the solver ranks off the game loop, it is nil when no hint is asked for
+	pendingHint chan string
+	// pendingAnalysis receives the guesses of the solver played off the game loop, it is nil when no analysis is asked for
+	pendingAnalysis chan []solver.Guess
 }
 
-func NewGame() *Game {
-	k := newKeyboard()
+func NewGame(settings Settings) (*Game, error) {
 	g := &Game{
-		keyboard: k,
-		board:    newBoard(k),
-		text:     NewTextRenderer(RobotoBoldFontName, redColor, 18),
+		text:           NewTextRenderer(RobotoBoldFontName, redColor, 18),
+		settings:       settings,
+		statsScreen:    newStatsScreen(),
+		analysisScreen: newAnalysisScreen(),
+	}
+
+	if err := g.setLanguage(settings.Language); err != nil {
+		return nil, err
+	}
+
+	if settings.Mode != ModeDaily {
+		g.resume()
+	}
+
+	return g, nil
+}
+
+// setLanguage starts a new game in the given language
+func (g *Game) setLanguage(language *core.Language) error {
+	s := g.settings
+	s.Language = language
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	dict, err := core.NewLanguageDictionary(language, s.WordLength)
+	if err != nil {
+		return err
+	}
+
+	g.settings = s
+	g.language = language
+	g.layout(dict)
+	g.solver = solver.New(dict)
+	g.solver.HardMode = s.HardMode
+	g.pendingHint = nil
+	g.message = ""
+	g.onGuess = nil
+	g.showStats = false
+	g.analysis = nil
+	g.pendingAnalysis = nil
+	g.statsPending = false
+	g.puzzle = 0
+	g.elapsed = 0
+	ebiten.SetWindowTitle(language.Message(core.MessageTitle))
+
+	g.stats, err = loadStats(s)
+	if err != nil {
+		log.Printf("statistics could not be loaded: %s", err)
+	}
+
+	if s.Mode == ModeDaily {
+		g.startDaily(time.Now())
+	}
+
+	if s.IsRun() {
+		g.startRun()
+	}
+
+	return nil
+}
+
+// layout creates the boards in a grid above the keyboard, the screen grows to fit several boards
+func (g *Game) layout(dict *core.Dictionary) {
+	n := g.settings.Boards
+	cols, rows := boardGrid(n)
+
+	g.width = ScreenWidth
+	area := image.Rect((ScreenWidth-boardWidth)/2, tileGap, (ScreenWidth+boardWidth)/2, tileGap+boardHeight)
+	if n > 1 {
+		g.width = max(ScreenWidth, cols*multiBoardWidth+2*tileGap)
+		h := max(boardHeight, rows*multiBoardHeight)
+		area = image.Rect((g.width-cols*multiBoardWidth)/2, tileGap, (g.width+cols*multiBoardWidth)/2, tileGap+h)
+	}
+
+	g.boards = make([]*board, n)
+	for i := range g.boards {
+		rect := image.Rect(
+			area.Min.X+area.Dx()*(i%cols)/cols,
+			area.Min.Y+area.Dy()*(i/cols)/rows,
+			area.Min.X+area.Dx()*(i%cols+1)/cols,
+			area.Min.Y+area.Dy()*(i/cols+1)/rows,
+		)
+		if n > 1 {
+			// keep a gap between the boards
+			rect = rect.Inset(2 * tileGap)
+		}
+
+		g.boards[i] = newBoard(dict, g.settings, rect)
+	}
+	g.distinctAnswers()
+
+	g.keyboard = newKeyboard(g.language, n, g.width, area.Max.Y+messageHeight)
+	g.height = max(ScreenHeight, area.Max.Y+messageHeight+g.keyboard.height()+tileGap)
+}
+
+// distinctAnswers picks new answers for the boards which play the answer of an earlier board
+func (g *Game) distinctAnswers() {
+	if g.settings.Mode == ModeAbsurdle {
+		return
+	}
+
+	used := map[string]bool{}
+	for _, b := range g.boards {
+		for used[b.rules.Answer()] && len(used) < len(b.dict.Targets()) {
+			b.rules.SetAnswer(b.dict.GetRandomWord())
+		}
+		used[b.rules.Answer()] = true
+	}
+}
+
+// newGame starts the next game with the same settings, the boards and the keyboard are reset in place
+func (g *Game) newGame() {
+	g.resetBoards()
+
+	g.message = ""
+	g.notice = ""
+	g.noticeTicks = 0
+	g.showStats = false
+	g.statsPending = false
+	g.analysis = nil
+	g.pendingAnalysis = nil
+	g.elapsed = 0
+
+	if g.settings.IsRun() {
+		g.startRun()
+	}
+}
+
+// resetBoards gives the boards and the keyboard a new word
+func (g *Game) resetBoards() {
+	for _, b := range g.boards {
+		b.reset()
+	}
+	g.distinctAnswers()
+	g.keyboard.reset()
+	g.pendingHint = nil
+}
+
+// finished reports whether the game is over, a run of the timed modes is over when the time is up
+func (g *Game) finished() bool {
+	if g.settings.IsRun() {
+		return g.run.over
+	}
+
+	return g.state() != core.StateInProgress
+}
+
+// canStartNewGame reports whether the finished game can be followed by a new one, the daily puzzle is played once a day
+func (g *Game) canStartNewGame() bool {
+	return g.settings.Mode != ModeDaily && g.finished()
+}
+
+// boardGrid returns the columns and rows the boards are laid out in, the keys of the keyboard are split the same way
+func boardGrid(boards int) (cols, rows int) {
+	switch {
+	case boards <= 1:
+		return 1, 1
+	case boards == 2:
+		return 2, 1
+	case boards <= 4:
+		return 2, 2
+	default:
+		return 4, 2
+	}
+}
+
+// startDaily sets the answers of the day and restores the guesses already made today
+func (g *Game) startDaily(now time.Time) {
+	number := core.DailyPuzzleNumber(now)
+	g.puzzle = number
+
+	// the number of guesses is left out so the puzzle of the day is played once whatever the guess setting is
+	name := fmt.Sprintf("daily-%s-%d.json", g.language.Name, g.settings.WordLength)
+	if len(g.boards) > 1 {
+		name = fmt.Sprintf("daily-%s-%d-x%d.json", g.language.Name, g.settings.WordLength, len(g.boards))
+	}
+	record := dailyRecord{}
+	if err := loadJSON(name, &record); err != nil {
+		log.Printf("daily puzzle progress could not be loaded: %s", err)
+	}
+
+	if record.Puzzle != number {
+		record = dailyRecord{Puzzle: number}
+	}
+
+	// the puzzle keeps the number of guesses it was started with, a launch with more guesses gives no extra tries
+	s := g.settings
+	s.Guesses = record.Rows
+	if record.Rows != g.settings.Guesses && s.Validate() == nil {
+		g.settings = s
+		g.layout(g.boards[0].dict)
 	}
+	record.Rows = g.settings.Guesses
 
-	return g
+	for i, b := range g.boards {
+		// every board gets the next puzzle in the order of the daily answers so a single board plays the puzzle of the day
+		b.rules.SetAnswer(b.dict.DailyWord((number-1)*len(g.boards) + i + 1))
+	}
+	ebiten.SetWindowTitle(fmt.Sprintf("%s #%d", g.language.Message(core.MessageTitle), number))
+
+	for _, b := range g.boards {
+		b.restoreGuesses(record.Guesses)
+	}
+	g.refreshKeyboard()
+
+	g.onGuess = func(guess string) {
+		record.Guesses = append(record.Guesses, guess)
+		if err := saveJSON(name, &record); err != nil {
+			log.Printf("daily puzzle progress could not be saved: %s", err)
+		}
+	}
+}
+
+// save keeps the game in progress for the next launch, the daily puzzle keeps its progress in its own file
+// and the runs of the timed modes are not saved
+func (g *Game) save() {
+	var words []string
+	for _, b := range g.boards {
+		if guesses := b.rules.Guesses(); len(guesses) > len(words) {
+			words = guesses
+		}
+	}
+
+	if g.settings.Mode == ModeDaily || g.settings.IsRun() || g.state() != core.StateInProgress || len(words) == 0 {
+		// the save of another game is kept unless it was resumed in this one
+		if g.resumed {
+			if err := removeJSON(saveFileName); err != nil {
+				log.Printf("saved game could not be removed: %s", err)
+			}
+		}
+
+		return
+	}
+
+	var answers []string
+	for _, b := range g.boards {
+		if g.settings.Mode != ModeAbsurdle {
+			answers = append(answers, b.rules.Answer())
+		}
+	}
+
+	if err := saveJSON(saveFileName, NewSavedGame(g.settings, answers, words, g.elapsed)); err != nil {
+		log.Printf("game could not be saved: %s", err)
+	}
+}
+
+// resume restores the saved game when it was saved with the same settings, the guesses are replayed without animations
+func (g *Game) resume() {
+	s, err := LoadSavedGame()
+	if err != nil {
+		log.Printf("saved game could not be loaded: %s", err)
+		return
+	}
+
+	if s == nil {
+		return
+	}
+
+	if !s.Matches(g.settings) {
+		// the save is kept for a launch with its settings
+		if s.Version == SaveVersion {
+			g.showNotice(g.language.Message(core.MessageOtherSave))
+		}
+		return
+	}
+
+	answers, err := s.RevealedAnswers()
+	if err != nil {
+		log.Printf("saved game could not be resumed: %s", err)
+		return
+	}
+
+	if g.settings.Mode == ModeAbsurdle {
+		answers = nil
+	} else if len(answers) != len(g.boards) {
+		log.Printf("saved game could not be resumed: %d answers for %d boards", len(answers), len(g.boards))
+		return
+	}
+
+	for i, a := range answers {
+		if !g.boards[i].dict.WordExists(a) {
+			log.Printf("saved game could not be resumed: the answer of board %d is not in the word list", i+1)
+			return
+		}
+	}
+
+	for i, b := range g.boards {
+		if answers != nil {
+			b.rules.SetAnswer(answers[i])
+		}
+		b.restoreGuesses(s.Words)
+	}
+	g.refreshKeyboard()
+	g.elapsed = s.ElapsedTime()
+	g.resumed = true
+
+	if g.state() != core.StateInProgress {
+		// a save is only written for a game in progress, the results of an edited file are not recorded
+		g.newGame()
+	}
+}
+
+// nextLanguage switches to the next language which has words of the current length
+func (g *Game) nextLanguage() {
+	for l := g.language.Next(); l != g.language; l = l.Next() {
+		if g.setLanguage(l) == nil {
+			return
+		}
+	}
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
-	return ScreenWidth, ScreenHeight
+	return g.Size()
+}
+
+// Size returns the size of the screen, it is bigger than the default size with more than two boards
+func (g *Game) Size() (width, height int) {
+	return g.width, g.height
+}
+
+// state is won once every board is solved and lost when a board runs out of guesses
+func (g *Game) state() core.State {
+	state := core.StateWon
+	for _, b := range g.boards {
+		switch b.state() {
+		case core.StateInProgress:
+			return core.StateInProgress
+		case core.StateLost:
+			state = core.StateLost
+		}
+	}
+
+	return state
+}
+
+// activeBoards returns the boards which are not solved yet, the guesses are typed into all of them
+func (g *Game) activeBoards() []*board {
+	var active []*board
+	for _, b := range g.boards {
+		if b.state() == core.StateInProgress {
+			active = append(active, b)
+		}
+	}
+
+	return active
+}
+
+func (g *Game) isWinAnimationFinished() bool {
+	for _, b := range g.boards {
+		if !b.IsWinAnimationFinished() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g *Game) maxY() float64 {
+	y := 0.0
+	for _, b := range g.boards {
+		y = max(y, b.maxY)
+	}
+
+	return y
 }
 
 func (g *Game) Update() error {
+	if ebiten.IsWindowBeingClosed() {
+		g.save()
+		return ebiten.Termination
+	}
+
+	g.clock += tick()
+	if g.state() == core.StateInProgress {
+		g.elapsed += tick()
+	}
+
+	if g.settings.IsRun() && !g.run.over {
+		g.updateRun()
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF1) {
+		g.nextLanguage()
+		return nil
+	}
+
+	if g.noticeTicks > 0 {
+		g.noticeTicks--
+	}
+
+	select {
+	case text := <-g.pendingHint:
+		g.pendingHint = nil
+		if text != "" {
+			g.showNotice(text)
+		}
+	case analysis := <-g.pendingAnalysis:
+		g.pendingAnalysis = nil
+		g.analysis = analysis
+		g.showStats = false
+		g.statsPending = false
+	default:
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF3) && g.finished() && !g.settings.IsRun() {
+		g.share()
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF4) {
+		if !g.finished() {
+			// the timed modes are played without hints so the best scores stay comparable
+			if !g.settings.IsRun() {
+				g.hint()
+			}
+		} else if g.analysis == nil {
+			g.solveGame()
+		} else {
+			g.analysis = nil
+		}
+
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF2) {
+		g.showStats = !g.showStats
+		g.analysis = nil
+		g.pendingAnalysis = nil
+		return nil
+	}
+
+	if g.showStats || g.analysis != nil {
+		p, released := g.releasedPoint()
+		if g.showStats && g.canStartNewGame() && (isEnterJustPressed() || released && p.In(newGameButton(g.width))) {
+			g.newGame()
+		} else if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || released {
+			g.showStats = false
+			g.analysis = nil
+		}
+
+		return nil
+	}
+
+	var inputRune rune
 	g.runes = ebiten.AppendInputChars(g.runes[:0])
 	if len(g.runes) > 0 {
-		g.board.inputRune = g.runes[0]
-	} else {
-		g.board.inputRune = 0
+		inputRune = g.runes[0]
 	}
 
+	var inputKey ebiten.Key
 	g.keys = inpututil.AppendJustPressedKeys(g.keys[:0])
 	if len(g.keys) > 0 {
-		g.board.inputKey = g.keys[0]
-	} else {
-		g.board.inputKey = 0
+		inputKey = g.keys[0]
 	}
 
-	g.board.Update()
-	if g.board.state != gameInProgress {
-		return nil
+	if r, k := g.keyboard.update(); r != 0 || k != 0 {
+		inputRune = r
+		inputKey = k
+	}
+
+	g.handleInput(inputRune, inputKey)
+
+	for _, b := range g.boards {
+		b.Update()
+	}
+
+	// a run can also end while the word is in progress
+	if g.statsPending && (g.state() != core.StateWon || g.isWinAnimationFinished()) {
+		g.statsPending = false
+		g.showStats = true
 	}
 
 	return nil
 }
 
+// releasedPoint returns where the mouse button or a touch was released in this tick
+func (g *Game) releasedPoint() (image.Point, bool) {
+	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		return image.Pt(ebiten.CursorPosition()), true
+	}
+
+	if ids := inpututil.AppendJustReleasedTouchIDs(nil); len(ids) > 0 {
+		return image.Pt(inpututil.TouchPositionInPreviousTick(ids[0])), true
+	}
+
+	return image.Point{}, false
+}
+
+func isEnterJustPressed() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter)
+}
+
+// handleInput types the input into the boards which are not solved yet, Enter starts a new game once the game is finished
+func (g *Game) handleInput(r rune, k ebiten.Key) {
+	active := g.activeBoards()
+	if len(active) == 0 || g.run.over {
+		if (k == ebiten.KeyEnter || k == ebiten.KeyNumpadEnter) && g.canStartNewGame() {
+			g.newGame()
+		}
+
+		return
+	}
+
+	switch {
+	case k == ebiten.KeyBackspace:
+		for _, b := range active {
+			if b.deleteCurrentChar() {
+				g.message = ""
+			}
+		}
+	case k == ebiten.KeyEnter || k == ebiten.KeyNumpadEnter:
+		g.checkCurrentWord(active)
+	case r > 0:
+		for _, b := range active {
+			b.addChar(r)
+		}
+	}
+}
+
+// checkCurrentWord submits the word typed into the active boards when every board accepts it,
+// in hard mode it has to use the hints of every active board
+func (g *Game) checkCurrentWord(active []*board) {
+	if !active[0].isPosInLastChar() {
+		return
+	}
+
+	guess := active[0].currentWord()
+	for _, b := range active {
+		if err := b.validate(); err != nil {
+			g.message = err.Error()
+			g.noticeTicks = 0
+			for _, a := range active {
+				a.shake()
+			}
+
+			return
+		}
+	}
+
+	row := active[0].pos / active[0].cols
+	for _, b := range active {
+		b.submit(true)
+	}
+	g.refreshKeyboard()
+	// a hint still being ranked is for the guesses before this one
+	g.pendingHint = nil
+
+	if g.onGuess != nil {
+		g.onGuess(string(guess))
+	}
+
+	if g.state() == core.StateInProgress {
+		if g.settings.Mode == ModeSpeed {
+			g.guessAccepted()
+		}
+	} else if g.settings.IsRun() {
+		g.finishWord(row)
+	} else {
+		g.recordStats(row)
+	}
+}
+
+// refreshKeyboard sets the key colours of every board from the letters of the scored guesses
+func (g *Game) refreshKeyboard() {
+	for i, b := range g.boards {
+		for r, s := range b.rules.LetterStatuses() {
+			g.keyboard.setKeyStatus(i, r, s)
+		}
+	}
+}
+
+func (g *Game) share() {
+	boards := make([][][]core.CharacterStatus, len(g.boards))
+	row := -1
+	for i, b := range g.boards {
+		boards[i] = b.rules.Results()
+		row = max(row, len(boards[i])-1)
+	}
+
+	if g.state() != core.StateWon {
+		row = -1
+	}
+
+	header := ShareHeader(g.language, g.puzzle, len(g.boards), row, g.settings.Guesses, g.settings.HardMode)
+	path, err := share(ShareBoardsText(header, boards, g.settings.ColorBlind))
+
+	switch {
+	case err != nil:
+		g.showNotice(err.Error())
+	case path == "":
+		g.showNotice(g.language.Message(core.MessageCopied))
+	default:
+		g.showNotice(fmt.Sprintf(g.language.Message(core.MessageSavedTo), path))
+	}
+}
+
+// showNotice shows the text for a while in place of the message below the boards, the message is cleared
+func (g *Game) showNotice(text string) {
+	g.message = ""
+	g.notice = text
+	g.noticeTicks = noticeTicks
+}
+
+// hint shows the best next guess of the solver and the number of the words the answer can still be,
+// with several boards the hint is for the active board with the fewest words left.
+// Ranking the opening guess takes a few hundred milliseconds, so the solver runs off the game loop
+// and Update shows the hint when it is ready.
+func (g *Game) hint() {
+	if g.pendingHint != nil {
+		return
+	}
+
+	var guesses []solver.Guess
+	var candidates []string
+	for _, b := range g.activeBoards() {
+		bg := b.guesses()
+		if c := g.solver.Candidates(bg); guesses == nil || len(c) < len(candidates) {
+			guesses, candidates = bg, c
+		}
+	}
+
+	hint := make(chan string, 1)
+	g.pendingHint = hint
+
+	s, message := g.solver, g.language.Message(core.MessageHint)
+	go func() {
+		if w := s.Best(guesses); w != "" {
+			hint <- fmt.Sprintf(message, w, len(candidates))
+		} else {
+			hint <- ""
+		}
+	}()
+}
+
+// solveGame plays the first board of the finished game with the solver off the game loop, in absurdle mode
+// against a new adversary, the analysis screen opens when the guesses are ready
+func (g *Game) solveGame() {
+	if g.pendingAnalysis != nil {
+		return
+	}
+
+	analysis := make(chan []solver.Guess, 1)
+	g.pendingAnalysis = analysis
+
+	b := g.boards[0]
+	s, dict, answer, rows, absurdle := g.solver, b.dict, b.GetCorrectAnswer(), b.rows, g.settings.Mode == ModeAbsurdle
+	go func() {
+		if absurdle {
+			analysis <- s.Play(core.NewAdversary(dict.Language(), dict.Targets()).Check, rows)
+		} else {
+			analysis <- s.Solve(answer, rows)
+		}
+	}()
+}
+
+func (g *Game) recordStats(row int) {
+	g.stats.Record(g.state() == core.StateWon, row)
+	if err := saveStats(g.settings, g.stats); err != nil {
+		log.Printf("statistics could not be saved: %s", err)
+	}
+
+	g.statsPending = true
+}
+
 func (g *Game) Draw(screen *ebiten.Image) {
 	screen.Fill(backgroundColor)
 
-	g.board.Draw(screen)
+	for _, b := range g.boards {
+		b.Draw(screen)
+	}
 	g.keyboard.draw(screen)
 
-	if g.board.wordNotFound {
-		g.setMessage(screen, "Not in Word List!!", redColor)
+	// there is one slot below the boards, the newest of the notice and the message is shown
+	state := g.state()
+	if g.noticeTicks > 0 {
+		g.setMessage(screen, g.notice, greenColor)
+	} else if g.message != "" {
+		g.setMessage(screen, g.message, redColor)
+	} else if state == core.StateLost {
+		g.setMessage(screen, g.correctAnswers(), redColor)
+	} else if g.settings.IsRun() {
+		g.setMessage(screen, fmt.Sprintf(g.language.Message(core.MessageScore), g.run.score), greenColor)
+	} else if state == core.StateWon && g.isWinAnimationFinished() {
+		g.setMessage(screen, g.language.Message(core.MessageYouWon), greenColor)
+	}
+
+	if g.settings.IsRun() && !g.run.over {
+		g.drawTimer(screen)
+	}
+
+	if g.showStats {
+		g.statsScreen.draw(screen, g.stats, g.settings.Guesses, g.language, g.result())
 	}
 
-	if g.board.state == gameLost {
-		g.setMessage(screen, g.board.GetCorrectAnswer(), redColor)
-	} else if g.board.state == gameWon && g.board.IsWinAnimationFinished() {
-		g.setMessage(screen, "You Won!!", greenColor)
+	if g.analysis != nil {
+		g.analysisScreen.draw(screen, g.analysis, g.language)
 	}
 }
 
+// result returns the result of the finished game for the stats screen, it is nil while the game is in progress
+func (g *Game) result() *gameResult {
+	if g.settings.IsRun() {
+		if !g.run.over {
+			return nil
+		}
+
+		clr := color.Color(color.Black)
+		if g.run.newBest {
+			clr = greenColor
+		}
+
+		detail := fmt.Sprintf(g.language.Message(core.MessageRunDetail), g.run.words, g.run.record.Score)
+
+		return &gameResult{fmt.Sprintf(g.language.Message(core.MessageScore), g.run.score), clr, detail, g.canStartNewGame()}
+	}
+
+	switch g.state() {
+	case core.StateWon:
+		return &gameResult{g.language.Message(core.MessageYouWon), greenColor, formatElapsed(g.elapsed), g.canStartNewGame()}
+	case core.StateLost:
+		return &gameResult{fmt.Sprintf(g.language.Message(core.MessageAnswer), g.correctAnswers()), redColor, formatElapsed(g.elapsed), g.canStartNewGame()}
+	}
+
+	return nil
+}
+
+// correctAnswers returns the answers of the boards which are not solved
+func (g *Game) correctAnswers() string {
+	var answers []string
+	for _, b := range g.boards {
+		if b.state() != core.StateWon {
+			answers = append(answers, b.GetCorrectAnswer())
+		}
+	}
+
+	return strings.Join(answers, " ")
+}
+
 func (g *Game) setMessage(screen *ebiten.Image, messageText string, color color.Color) {
 	g.text.SetColor(color)
-	g.text.Draw(screen, messageText, screen.Bounds().Dx()/2, int(g.board.maxY)+30)
+	g.text.Draw(screen, messageText, screen.Bounds().Dx()/2, int(g.maxY())+30)
 }
@@ -1,19 +1,27 @@
 package wordle
 
 import (
+	"image"
 	"image/color"
-	"unicode"
 
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
+const keyboardEnterText = "ENTER"
+
 type keyboard struct {
-	rows    *[]*keyboardRow
-	keysMap *map[rune]*keyboardKey
-	text    *TextRenderer
-	boxW    int
-	boxH    int
-	boxGap  int
+	rows      *[]*keyboardRow
+	keysMap   *map[rune]*keyboardKey
+	text      *TextRenderer
+	smallText *TextRenderer
+	boxW      int
+	boxH      int
+	boxGap    int
+	wideBoxW  int
+	touchIDs  []ebiten.TouchID
 }
 
 type keyboardRow struct {
@@ -22,27 +30,53 @@ type keyboardRow struct {
 }
 
 type keyboardKey struct {
-	char   rune
-	status CharacterStatus
+	char rune
+	// key is set for the keys without a character, Enter and Backspace
+	key ebiten.Key
+	// statuses holds the status of the key on every board
+	statuses []core.CharacterStatus
+	rect     image.Rectangle
+	hovered  bool
+	pressed  bool
 }
 
-func newKeyboard() *keyboard {
+// newKeyboard creates the keyboard of the language centred in the width of the screen with the top at y
+func newKeyboard(language *core.Language, boards, width, y int) *keyboard {
 	keyboard := &keyboard{
-		boxW:   30,
-		boxH:   50,
-		boxGap: 3,
-		text:   NewTextRenderer(RobotoBoldFontName, color.White, 18),
-		rows:   createKeyboardKeyRows("ERTYUIOPĞÜ", "ASDFGHJKLŞİ", "ZCVBNMÖÇ"),
+		boxW:      30,
+		boxH:      50,
+		boxGap:    3,
+		wideBoxW:  48,
+		text:      NewTextRenderer(RobotoBoldFontName, color.White, 18),
+		smallText: NewTextRenderer(RobotoBoldFontName, color.White, 12),
+		rows:      createKeyboardKeyRows(boards, language.KeyboardRows...),
 	}
 
+	// the last row gets the wide Enter and Backspace keys on both sides
+	last := (*keyboard.rows)[len(*keyboard.rows)-1]
+	keys := append([]*keyboardKey{{key: ebiten.KeyEnter}}, *last.keys...)
+	keys = append(keys, &keyboardKey{key: ebiten.KeyBackspace})
+	last.keys = &keys
+
 	keysMap := make(map[rune]*keyboardKey)
 
 	for _, r := range *keyboard.rows {
-		r.width = len(*r.keys)*(keyboard.boxW+keyboard.boxGap) - keyboard.boxGap
+		r.width = -keyboard.boxGap
+		for _, k := range *r.keys {
+			r.width += keyboard.keyWidth(k) + keyboard.boxGap
+		}
 
+		x := (width - r.width) / 2
 		for _, k := range *r.keys {
-			keysMap[k.char] = k
+			k.rect = image.Rect(x, y, x+keyboard.keyWidth(k), y+keyboard.boxH)
+			x += k.rect.Dx() + keyboard.boxGap
+
+			if k.char != 0 {
+				keysMap[k.char] = k
+			}
 		}
+
+		y += keyboard.boxH + keyboard.boxGap
 	}
 
 	keyboard.keysMap = &keysMap
@@ -50,65 +84,178 @@ func newKeyboard() *keyboard {
 	return keyboard
 }
 
-func (k *keyboard) setKeyStatus(r rune, s CharacterStatus) {
-	if key, exists := (*k.keysMap)[unicode.TurkishCase.ToUpper(r)]; exists {
-		if key.status == CharacterStatusCorrectLocation {
-			return
-		}
+func (k *keyboard) keyWidth(key *keyboardKey) int {
+	if key.char == 0 {
+		return k.wideBoxW
+	}
+
+	return k.boxW
+}
+
+// height returns the height of the keyboard rows
+func (k *keyboard) height() int {
+	return len(*k.rows)*(k.boxH+k.boxGap) - k.boxGap
+}
 
-		if key.status == CharacterStatusWrongLocation && s != CharacterStatusCorrectLocation {
-			return
+// setKeyStatus sets the colour of the key on the board, see core.Board.LetterStatuses
+func (k *keyboard) setKeyStatus(board int, r rune, s core.CharacterStatus) {
+	if key, exists := (*k.keysMap)[r]; exists {
+		key.statuses[board] = s
+	}
+}
+
+// reset clears the statuses of the keys for a new game
+func (k *keyboard) reset() {
+	for _, key := range *k.keysMap {
+		clear(key.statuses)
+	}
+}
+
+// update tracks the mouse and touches over the keys and returns the character or the key
+// of the key released in this tick, the result is used like the input of the physical keyboard
+func (k *keyboard) update() (rune, ebiten.Key) {
+	var points []image.Point
+	k.touchIDs = ebiten.AppendTouchIDs(k.touchIDs[:0])
+	for _, id := range k.touchIDs {
+		points = append(points, image.Pt(ebiten.TouchPosition(id)))
+	}
+
+	cursor := image.Pt(ebiten.CursorPosition())
+	mousePressed := ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+
+	var released []image.Point
+	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		released = append(released, cursor)
+	}
+
+	k.touchIDs = inpututil.AppendJustReleasedTouchIDs(k.touchIDs[:0])
+	for _, id := range k.touchIDs {
+		released = append(released, image.Pt(inpututil.TouchPositionInPreviousTick(id)))
+	}
+
+	var result *keyboardKey
+	for _, r := range *k.rows {
+		for _, key := range *r.keys {
+			key.hovered = cursor.In(key.rect)
+			key.pressed = key.hovered && mousePressed
+
+			for _, p := range points {
+				if p.In(key.rect) {
+					key.pressed = true
+				}
+			}
+
+			for _, p := range released {
+				if p.In(key.rect) {
+					result = key
+				}
+			}
 		}
+	}
 
-		key.status = s
+	if result == nil {
+		return 0, 0
 	}
+
+	return result.char, result.key
 }
 
 func (k *keyboard) draw(screen *ebiten.Image) {
-	y := 438
 	for _, r := range *k.rows {
-		x := (screen.Bounds().Dx() - r.width) / 2
 		for _, key := range *r.keys {
 			op := &ebiten.DrawImageOptions{}
-			op.GeoM.Translate(float64(x), float64(y))
+			op.GeoM.Translate(float64(key.rect.Min.X), float64(key.rect.Min.Y))
 
-			keyboardButton := ebiten.NewImage(k.boxW, k.boxH)
-			btnColor := key.status.getColor()
-			if btnColor == color.White {
-				btnColor = lightGrayColor
+			keyboardButton := ebiten.NewImage(key.rect.Dx(), key.rect.Dy())
+			if key.char == 0 {
+				keyboardButton.Fill(lightGrayColor)
 			}
-			keyboardButton.Fill(btnColor)
 
-			k.text.Draw(keyboardButton, string(unicode.TurkishCase.ToUpper(key.char)), keyboardButton.Bounds().Dx()/2, keyboardButton.Bounds().Dy()/2)
-			screen.DrawImage(keyboardButton, op)
+			// with several boards the key is split into the colours of the boards, in the layout of the boards
+			cols, rows := boardGrid(len(key.statuses))
+			for i, s := range key.statuses {
+				btnColor := statusColor(s)
+				if btnColor == color.White {
+					btnColor = lightGrayColor
+				}
+
+				x0, y0 := key.rect.Dx()*(i%cols)/cols, key.rect.Dy()*(i/cols)/rows
+				x1, y1 := key.rect.Dx()*(i%cols+1)/cols, key.rect.Dy()*(i/cols+1)/rows
+				vector.DrawFilledRect(keyboardButton, float32(x0), float32(y0), float32(x1-x0), float32(y1-y0), btnColor, false)
+			}
 
-			x += k.boxW + k.boxGap
+			if key.pressed {
+				op.ColorScale.Scale(0.75, 0.75, 0.75, 1)
+			} else if key.hovered {
+				op.ColorScale.Scale(1.15, 1.15, 1.15, 1)
+			}
+
+			w, h := keyboardButton.Bounds().Dx(), keyboardButton.Bounds().Dy()
+			switch key.key {
+			case ebiten.KeyEnter:
+				k.smallText.Draw(keyboardButton, keyboardEnterText, w/2, h/2)
+			case ebiten.KeyBackspace:
+				drawBackspaceIcon(keyboardButton, float32(w)/2, float32(h)/2)
+			default:
+				k.text.Draw(keyboardButton, string(key.char), w/2, h/2)
+			}
+
+			screen.DrawImage(keyboardButton, op)
 		}
+	}
+}
+
+// drawBackspaceIcon draws ⌫ centred at x, y, the embedded fonts do not have the glyph
+func drawBackspaceIcon(dst *ebiten.Image, x, y float32) {
+	var path vector.Path
+	path.MoveTo(x-12, y)
+	path.LineTo(x-5, y-8)
+	path.LineTo(x+12, y-8)
+	path.LineTo(x+12, y+8)
+	path.LineTo(x-5, y+8)
+	path.Close()
+
+	path.MoveTo(x-1, y-4)
+	path.LineTo(x+7, y+4)
+	path.MoveTo(x+7, y-4)
+	path.LineTo(x-1, y+4)
 
-		y += k.boxH + k.boxGap
+	vs, is := path.AppendVerticesAndIndicesForStroke(nil, nil, &vector.StrokeOptions{Width: 2, LineJoin: vector.LineJoinRound})
+	for i := range vs {
+		vs[i].SrcX, vs[i].SrcY = 1, 1
+		vs[i].ColorR, vs[i].ColorG, vs[i].ColorB, vs[i].ColorA = 1, 1, 1, 1
 	}
+
+	dst.DrawTriangles(vs, is, whitePixel, &ebiten.DrawTrianglesOptions{AntiAlias: true})
 }
 
-func createKeyboardKeyRows(rows ...string) *[]*keyboardRow {
+var whitePixel = func() *ebiten.Image {
+	img := ebiten.NewImage(3, 3)
+	img.Fill(color.White)
+
+	return img
+}()
+
+func createKeyboardKeyRows(boards int, rows ...string) *[]*keyboardRow {
 	result := make([]*keyboardRow, len(rows))
 
 	for i, r := range rows {
 		result[i] = &keyboardRow{
-			keys: createKeyboardKeyArray(r),
+			keys: createKeyboardKeyArray(boards, r),
 		}
 	}
 
 	return &result
 }
 
-func createKeyboardKeyArray(keys string) *[]*keyboardKey {
+func createKeyboardKeyArray(boards int, keys string) *[]*keyboardKey {
 	runeArray := []rune(keys)
 	result := make([]*keyboardKey, len(runeArray))
 
 	for i, r := range runeArray {
 		result[i] = &keyboardKey{
-			char:   r,
-			status: CharacterStatusNone,
+			char:     r,
+			statuses: make([]core.CharacterStatus, boards),
 		}
 	}
 
@@ -0,0 +1,173 @@
+package wordle
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	recordsFileName = "records.json"
+
+	// wordPause is the time the finished word stays on the screen before the next word of a run starts
+	wordPause = 1500 * time.Millisecond
+	// timerBarHeight is the height of the bar showing the time left in the timed modes
+	timerBarHeight = 6
+)
+
+// Record is the best run of a timed mode
+type Record struct {
+	Score int `json:"score"`
+	Words int `json:"words"`
+}
+
+// run is the state of a timed or speed run, the times are on the clock of the game loop
+type run struct {
+	score int
+	words int
+	over  bool
+	// newBest is true when the finished run beat the record
+	newBest bool
+	record  Record
+
+	// deadline is the end of a timed run, guessDeadline the end of the current guess in speed mode
+	deadline      time.Duration
+	guessDeadline time.Duration
+	// wordStart is when the current word started, nextWord when the next word starts after a finished word
+	wordStart time.Duration
+	nextWord  time.Duration
+}
+
+// WordScore returns the points of a word found on the 0 based row in solveTime. Every guess left is worth
+// 100 points and a word found in less than a minute gets 5 points for every second left of the minute.
+func WordScore(row, guesses int, solveTime time.Duration) int {
+	return (guesses-row)*100 + max(0, 60-int(solveTime.Seconds()))*5
+}
+
+// tick returns the time of a tick of the game loop
+func tick() time.Duration {
+	return time.Second / time.Duration(ebiten.TPS())
+}
+
+func recordKey(settings Settings) string {
+	return fmt.Sprintf("%s-%s", statsKey(settings), settings.Limit())
+}
+
+// loadRecord returns the best run of the settings from the records file
+func loadRecord(settings Settings) (Record, error) {
+	all := map[string]Record{}
+	err := loadJSON(recordsFileName, &all)
+
+	return all[recordKey(settings)], err
+}
+
+// saveRecord replaces the best run of the settings in the records file, the records of the other settings are kept
+func saveRecord(settings Settings, r Record) error {
+	all := map[string]Record{}
+	if err := loadJSON(recordsFileName, &all); err != nil {
+		return err
+	}
+
+	all[recordKey(settings)] = r
+
+	return saveJSON(recordsFileName, all)
+}
+
+// startRun starts a new run of words on the clock
+func (g *Game) startRun() {
+	limit := g.settings.Limit()
+	g.run = run{
+		deadline:      g.clock + limit,
+		guessDeadline: g.clock + limit,
+		wordStart:     g.clock,
+	}
+
+	var err error
+	if g.run.record, err = loadRecord(g.settings); err != nil {
+		log.Printf("records could not be loaded: %s", err)
+	}
+}
+
+// updateRun ends the run when the time is up and starts the next word once the finished word was shown
+func (g *Game) updateRun() {
+	state := g.state()
+	switch {
+	case g.settings.Mode == ModeTimed && g.clock >= g.run.deadline:
+		g.endRun()
+	case g.settings.Mode == ModeSpeed && state == core.StateInProgress && g.clock >= g.run.guessDeadline:
+		g.endRun()
+	case state != core.StateInProgress && g.clock >= g.run.nextWord && (state == core.StateLost || g.isWinAnimationFinished()):
+		g.resetBoards()
+		g.message = ""
+		g.run.wordStart = g.clock
+		g.run.guessDeadline = g.clock + g.settings.Limit()
+	}
+}
+
+// guessAccepted gives the next guess of speed mode its full time
+func (g *Game) guessAccepted() {
+	g.run.guessDeadline = g.clock + g.settings.Limit()
+}
+
+// finishWord scores the finished word of the run, in speed mode a word which is not found ends the run
+func (g *Game) finishWord(row int) {
+	won := g.state() == core.StateWon
+	g.stats.Record(won, row)
+	if err := saveStats(g.settings, g.stats); err != nil {
+		log.Printf("statistics could not be saved: %s", err)
+	}
+
+	if won {
+		g.run.score += WordScore(row, g.settings.Guesses, g.clock-g.run.wordStart)
+		g.run.words++
+	} else if g.settings.Mode == ModeSpeed {
+		g.endRun()
+		return
+	}
+
+	g.run.nextWord = g.clock + wordPause
+}
+
+// endRun stops the run and saves the score when it is the best of the mode
+func (g *Game) endRun() {
+	g.run.over = true
+	g.statsPending = true
+
+	if g.run.score <= g.run.record.Score {
+		return
+	}
+
+	g.run.newBest = true
+	g.run.record = Record{Score: g.run.score, Words: g.run.words}
+	if err := saveRecord(g.settings, g.run.record); err != nil {
+		log.Printf("records could not be saved: %s", err)
+	}
+}
+
+// drawTimer draws the time left below the boards, the bar turns red in the last quarter
+func (g *Game) drawTimer(screen *ebiten.Image) {
+	left := g.run.deadline - g.clock
+	if g.settings.Mode == ModeSpeed {
+		left = g.run.guessDeadline - g.clock
+		if g.state() != core.StateInProgress {
+			left = g.settings.Limit()
+		}
+	}
+
+	limit := g.settings.Limit()
+	left = min(max(left, 0), limit)
+
+	x, y := float32(20), float32(g.maxY())+6
+	w := float32(g.width) - 2*x
+	vector.DrawFilledRect(screen, x, y, w, timerBarHeight, lightGrayColor, false)
+
+	clr := greenColor
+	if left < limit/4 {
+		clr = redColor
+	}
+	vector.DrawFilledRect(screen, x, y, w*float32(left)/float32(limit), timerBarHeight, clr, false)
+}
@@ -0,0 +1,88 @@
+package wordle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/game"
+)
+
+func TestWordScore(t *testing.T) {
+	testCases := []struct {
+		row       int
+		guesses   int
+		solveTime time.Duration
+		expected  int
+	}{
+		{0, 6, 10 * time.Second, 600 + 250},
+		{5, 6, 10 * time.Second, 100 + 250},
+		{2, 6, 59*time.Second + 500*time.Millisecond, 400 + 5},
+		{2, 6, 2 * time.Minute, 400},
+	}
+
+	for _, tc := range testCases {
+		if actual := wordle.WordScore(tc.row, tc.guesses, tc.solveTime); actual != tc.expected {
+			t.Errorf("WordScore(%d, %d, %s) expected=%d actual=%d", tc.row, tc.guesses, tc.solveTime, tc.expected, actual)
+		}
+	}
+
+	if wordle.WordScore(1, 6, 20*time.Second) <= wordle.WordScore(2, 6, 20*time.Second) {
+		t.Error("fewer guesses should score more")
+	}
+
+	if wordle.WordScore(1, 6, 20*time.Second) <= wordle.WordScore(1, 6, 30*time.Second) {
+		t.Error("a faster solve should score more")
+	}
+}
+
+func TestRunSettings(t *testing.T) {
+	s := wordle.DefaultSettings()
+	s.Mode = wordle.ModeTimed
+	if err := s.Validate(); err != nil || !s.IsRun() || s.Limit() != 3*time.Minute {
+		t.Errorf("timed mode err=%v run=%v limit=%s", err, s.IsRun(), s.Limit())
+	}
+
+	s.Mode = wordle.ModeSpeed
+	if s.Limit() != 20*time.Second {
+		t.Errorf("expected the default guess time of 20s, got %s", s.Limit())
+	}
+
+	s.TimeLimit = 45 * time.Second
+	if s.Limit() != 45*time.Second {
+		t.Errorf("expected the time limit of 45s, got %s", s.Limit())
+	}
+
+	s.Boards = 2
+	s.Guesses = wordle.DefaultGuesses(2)
+	if err := s.Validate(); err == nil {
+		t.Error("expected an error for a speed run on two boards")
+	}
+}
+
+func TestBoardGuesses(t *testing.T) {
+	testCases := []struct {
+		boards  int
+		guesses int
+		valid   bool
+	}{
+		{1, wordle.MaxGuesses, true},
+		{1, wordle.MaxGuesses + 1, false},
+		{2, wordle.MaxGuesses, true},
+		{2, wordle.MaxGuesses + 1, false},
+		{4, 11, true},
+		{4, 12, false},
+		{8, wordle.DefaultGuesses(8), true},
+		{8, 15, true},
+		{8, 16, false},
+		{8, wordle.MinGuesses - 1, false},
+	}
+
+	for _, tc := range testCases {
+		s := wordle.DefaultSettings()
+		s.Boards = tc.boards
+		s.Guesses = tc.guesses
+		if err := s.Validate(); (err == nil) != tc.valid {
+			t.Errorf("%d guesses on %d boards expected valid=%v, err=%v", tc.guesses, tc.boards, tc.valid, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package wordle
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+const (
+	saveFileName = "save.json"
+	// SaveVersion is increased when the format of the save file changes, saves of the other versions are not resumed
+	SaveVersion = 1
+)
+
+// saveKey is xored with the answers so they cannot be read from the save file at a glance, it is not a secret
+var saveKey = []byte("wordle")
+
+// SavedGame is the game in progress, it is saved when the window is closed and resumed on the next launch
+type SavedGame struct {
+	Version    int    `json:"version"`
+	Language   string `json:"language"`
+	WordLength int    `json:"wordLength"`
+	Guesses    int    `json:"guesses"`
+	Boards     int    `json:"boards"`
+	Mode       string `json:"mode"`
+	HardMode   bool   `json:"hardMode"`
+	// Answers are the obfuscated answers of the boards, empty in absurdle mode
+	Answers []string `json:"answers"`
+	// Words are the guesses entered so far
+	Words []string `json:"words"`
+	// Elapsed is the playing time in seconds
+	Elapsed float64 `json:"elapsed"`
+}
+
+// NewSavedGame returns the save of a game played with the settings
+func NewSavedGame(settings Settings, answers, words []string, elapsed time.Duration) *SavedGame {
+	s := &SavedGame{
+		Version:    SaveVersion,
+		Language:   settings.Language.Name,
+		WordLength: settings.WordLength,
+		Guesses:    settings.Guesses,
+		Boards:     settings.Boards,
+		Mode:       settings.Mode,
+		HardMode:   settings.HardMode,
+		Words:      words,
+		Elapsed:    elapsed.Seconds(),
+	}
+
+	for _, a := range answers {
+		s.Answers = append(s.Answers, ObfuscateAnswer(a))
+	}
+
+	return s
+}
+
+// Matches reports whether the saved game can be resumed with the settings, the game is only resumed
+// when it is started with the same settings it was saved with
+func (s *SavedGame) Matches(settings Settings) bool {
+	return s.Version == SaveVersion &&
+		s.Language == settings.Language.Name &&
+		s.WordLength == settings.WordLength &&
+		s.Guesses == settings.Guesses &&
+		s.Boards == settings.Boards &&
+		s.Mode == settings.Mode &&
+		s.HardMode == settings.HardMode
+}
+
+// Settings returns the settings with the ones the game was saved with, the settings which are not saved
+// like the time limit are kept
+func (s *SavedGame) Settings(settings Settings) (Settings, error) {
+	if s.Version != SaveVersion {
+		return settings, fmt.Errorf("save version %d cannot be resumed", s.Version)
+	}
+
+	language, err := core.GetLanguage(s.Language)
+	if err != nil {
+		return settings, err
+	}
+
+	settings.Language = language
+	settings.WordLength = s.WordLength
+	settings.Guesses = s.Guesses
+	settings.Boards = s.Boards
+	settings.Mode = s.Mode
+	settings.HardMode = s.HardMode
+
+	return settings, settings.Validate()
+}
+
+// LoadSavedGame returns the game saved on the last close, nil when there is none
+func LoadSavedGame() (*SavedGame, error) {
+	s := &SavedGame{}
+	if err := loadJSON(saveFileName, s); err != nil || len(s.Words) == 0 {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// RevealedAnswers returns the answers of the boards
+func (s *SavedGame) RevealedAnswers() ([]string, error) {
+	answers := make([]string, len(s.Answers))
+	for i, a := range s.Answers {
+		var err error
+		if answers[i], err = RevealAnswer(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return answers, nil
+}
+
+// ElapsedTime returns the saved playing time
+func (s *SavedGame) ElapsedTime() time.Duration {
+	return time.Duration(s.Elapsed * float64(time.Second))
+}
+
+// ObfuscateAnswer hides the answer from a casual look at the save file
+func ObfuscateAnswer(answer string) string {
+	return base64.StdEncoding.EncodeToString(xorSaveKey([]byte(answer)))
+}
+
+// RevealAnswer returns the answer hidden by ObfuscateAnswer
+func RevealAnswer(obfuscated string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(obfuscated)
+	if err != nil {
+		return "", fmt.Errorf("saved answer '%s' cannot be read: %w", obfuscated, err)
+	}
+
+	return string(xorSaveKey(b)), nil
+}
+
+func xorSaveKey(b []byte) []byte {
+	for i := range b {
+		b[i] ^= saveKey[i%len(saveKey)]
+	}
+
+	return b
+}
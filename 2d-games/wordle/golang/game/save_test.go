@@ -0,0 +1,116 @@
+package wordle_test
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/game"
+)
+
+func TestObfuscateAnswer(t *testing.T) {
+	for _, answer := range []string{"KALEM", "ÇİÇEK", "BREAD"} {
+		obfuscated := wordle.ObfuscateAnswer(answer)
+		if strings.Contains(obfuscated, answer) {
+			t.Errorf("obfuscated answer %s shows %s", obfuscated, answer)
+		}
+
+		revealed, err := wordle.RevealAnswer(obfuscated)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if revealed != answer {
+			t.Errorf("expected=%s actual=%s", answer, revealed)
+		}
+	}
+
+	if _, err := wordle.RevealAnswer("not base64!"); err == nil {
+		t.Error("expected an error for a broken answer")
+	}
+}
+
+func TestSavedGame(t *testing.T) {
+	settings := wordle.DefaultSettings()
+	saved := wordle.NewSavedGame(settings, []string{"KALEM"}, []string{"ŞAPKA", "KARIN"}, 83*time.Second)
+
+	data, err := json.Marshal(saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(data), "KALEM") {
+		t.Errorf("save file shows the answer: %s", data)
+	}
+
+	loaded := &wordle.SavedGame{}
+	if err := json.Unmarshal(data, loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !loaded.Matches(settings) {
+		t.Error("the save should match the settings it was saved with")
+	}
+
+	answers, err := loaded.RevealedAnswers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(answers, []string{"KALEM"}) || !slices.Equal(loaded.Words, saved.Words) || loaded.ElapsedTime() != 83*time.Second {
+		t.Errorf("unexpected answers %v words %v elapsed %v", answers, loaded.Words, loaded.ElapsedTime())
+	}
+
+	other := settings
+	other.Language = core.English
+	if loaded.Matches(other) {
+		t.Error("the save should not match another language")
+	}
+
+	other = settings
+	other.HardMode = true
+	if loaded.Matches(other) {
+		t.Error("the save should not match hard mode")
+	}
+
+	loaded.Version = wordle.SaveVersion + 1
+	if loaded.Matches(settings) {
+		t.Error("the save of another version should not match")
+	}
+}
+
+func TestSavedGameSettings(t *testing.T) {
+	saved := wordle.DefaultSettings()
+	saved.Language = core.English
+	saved.Mode = wordle.ModeAbsurdle
+	saved.HardMode = true
+
+	launch := wordle.DefaultSettings()
+	launch.ColorBlind = true
+
+	s, err := wordle.NewSavedGame(saved, nil, []string{"CRANE"}, 0).Settings(launch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Language != core.English || s.Mode != wordle.ModeAbsurdle || !s.HardMode || !s.ColorBlind {
+		t.Errorf("expected the saved settings with the colour blind setting of the launch, actual %+v", s)
+	}
+
+	old := wordle.NewSavedGame(saved, nil, []string{"CRANE"}, 0)
+	old.Version = wordle.SaveVersion + 1
+	if _, err := old.Settings(launch); err == nil {
+		t.Error("the settings of another save version should not be used")
+	}
+}
+
+func TestLoadSavedGameWithoutSave(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if s, err := wordle.LoadSavedGame(); s != nil || err != nil {
+		t.Errorf("expected no save, actual %+v err=%v", s, err)
+	}
+}
@@ -0,0 +1,118 @@
+package wordle
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+const (
+	MinGuesses = core.MinGuesses
+	// MaxGuesses is the most guesses of a single board, several boards can have more, see MaxBoardGuesses
+	MaxGuesses = core.MaxGuesses
+	// extraBoardGuesses is how many guesses more than the default several boards can be played with
+	extraBoardGuesses = 2
+
+	ModePractice = core.ModePractice
+	ModeDaily    = core.ModeDaily
+	ModeAbsurdle = core.ModeAbsurdle
+	// ModeTimed is a run of words against a countdown, the next word starts once a word is finished
+	ModeTimed = "timed"
+	// ModeSpeed is a run of words with a time limit for every guess, the run ends with the first word which is not found
+	ModeSpeed = "speed"
+
+	defaultTimedLimit = 3 * time.Minute
+	defaultSpeedLimit = 20 * time.Second
+)
+
+// Modes are the modes of the game, the timed modes are only played in the game window
+var Modes = []string{ModePractice, ModeDaily, ModeAbsurdle, ModeTimed, ModeSpeed}
+
+// BoardCounts are the numbers of boards which can be played at once, Dordle, Quordle and Octordle after the single board
+var BoardCounts = []int{1, 2, 4, 8}
+
+type Settings struct {
+	Language   *core.Language
+	WordLength int
+	Guesses    int
+	Mode       string
+	// Boards is the number of answers found with the same guesses, a board locks once it is solved
+	Boards int
+	// HardMode requires every guess to use the revealed hints
+	HardMode bool
+	// ColorBlind shares the results with high contrast symbols
+	ColorBlind bool
+	// TimeLimit is the length of a timed run or the time of a guess in speed mode, 0 is the default of the mode
+	TimeLimit time.Duration
+}
+
+func DefaultSettings() Settings {
+	return Settings{
+		Language:   core.Turkish,
+		WordLength: 5,
+		Guesses:    DefaultGuesses(1),
+		Mode:       ModePractice,
+		Boards:     1,
+	}
+}
+
+// DefaultGuesses returns the number of guesses for the number of boards, every extra board needs an extra guess
+func DefaultGuesses(boards int) int {
+	return boards + 5
+}
+
+// MaxBoardGuesses returns the most guesses for the number of boards, several boards can have a few more than their default
+func MaxBoardGuesses(boards int) int {
+	if boards <= 1 {
+		return MaxGuesses
+	}
+
+	return max(MaxGuesses, DefaultGuesses(boards)+extraBoardGuesses)
+}
+
+func (s Settings) Validate() error {
+	if s.WordLength < core.MinWordLength || s.WordLength > core.MaxWordLength {
+		return fmt.Errorf("word length must be between %d and %d, got %d", core.MinWordLength, core.MaxWordLength, s.WordLength)
+	}
+
+	if !slices.Contains(BoardCounts, s.Boards) {
+		return fmt.Errorf("number of boards must be one of %v, got %d", BoardCounts, s.Boards)
+	}
+
+	if maxGuesses := MaxBoardGuesses(s.Boards); s.Guesses < MinGuesses || s.Guesses > maxGuesses {
+		return fmt.Errorf("guesses must be between %d and %d with %d boards, got %d", MinGuesses, maxGuesses, s.Boards, s.Guesses)
+	}
+
+	if !slices.Contains(Modes, s.Mode) {
+		return fmt.Errorf("unknown mode '%s', available modes: %v", s.Mode, Modes)
+	}
+
+	if s.Boards > 1 && (s.Mode == ModeAbsurdle || s.IsRun()) {
+		return fmt.Errorf("%s mode is played on a single board", s.Mode)
+	}
+
+	if s.TimeLimit < 0 {
+		return fmt.Errorf("time limit cannot be negative, got %s", s.TimeLimit)
+	}
+
+	return nil
+}
+
+// IsRun reports whether the mode is played against the clock with a new word after every finished word
+func (s Settings) IsRun() bool {
+	return s.Mode == ModeTimed || s.Mode == ModeSpeed
+}
+
+// Limit returns the time limit of the run or of a guess
+func (s Settings) Limit() time.Duration {
+	switch {
+	case s.TimeLimit > 0:
+		return s.TimeLimit
+	case s.Mode == ModeSpeed:
+		return defaultSpeedLimit
+	default:
+		return defaultTimedLimit
+	}
+}
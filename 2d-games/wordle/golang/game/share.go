@@ -0,0 +1,158 @@
+package wordle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+const shareFileName = "share.txt"
+
+var (
+	shareSymbols = map[core.CharacterStatus]string{
+		core.CharacterStatusCorrectLocation: "🟩",
+		core.CharacterStatusWrongLocation:   "🟨",
+		core.CharacterStatusNotPresent:      "⬛",
+	}
+
+	// colorBlindShareSymbols use the high contrast orange and blue instead of green and yellow
+	colorBlindShareSymbols = map[core.CharacterStatus]string{
+		core.CharacterStatusCorrectLocation: "🟧",
+		core.CharacterStatusWrongLocation:   "🟦",
+		core.CharacterStatusNotPresent:      "⬛",
+	}
+)
+
+// boardsNames are the names of the games with several boards
+var boardsNames = map[int]string{
+	2: "Dordle",
+	4: "Quordle",
+	8: "Octordle",
+}
+
+// ShareHeader returns the first line of a shared result like "Wordle TR #123 4/6*" or "Quordle TR 8/9".
+// puzzle is 0 outside the daily mode, row is the 0 based winning row or -1 for a lost game.
+func ShareHeader(language *core.Language, puzzle, boards, row, guesses int, hardMode bool) string {
+	name, ok := boardsNames[boards]
+	if !ok {
+		name = "Wordle"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(name)
+	sb.WriteRune(' ')
+	sb.WriteString(strings.ToUpper(language.Name))
+
+	if puzzle > 0 {
+		fmt.Fprintf(&sb, " #%d", puzzle)
+	}
+
+	if row < 0 {
+		fmt.Fprintf(&sb, " X/%d", guesses)
+	} else {
+		fmt.Fprintf(&sb, " %d/%d", row+1, guesses)
+	}
+
+	if hardMode {
+		sb.WriteRune('*')
+	}
+
+	return sb.String()
+}
+
+// ShareText returns the header followed by a line of squares for every guess, the letters are not included
+func ShareText(header string, results [][]core.CharacterStatus, colorBlind bool) string {
+	return ShareBoardsText(header, [][][]core.CharacterStatus{results}, colorBlind)
+}
+
+// ShareBoardsText returns the header followed by the squares of every board, the boards are separated by an empty line
+func ShareBoardsText(header string, boards [][][]core.CharacterStatus, colorBlind bool) string {
+	symbols := shareSymbols
+	if colorBlind {
+		symbols = colorBlindShareSymbols
+	}
+
+	lines := []string{header}
+	for _, results := range boards {
+		lines = append(lines, "")
+		for _, result := range results {
+			var sb strings.Builder
+			for _, s := range result {
+				sb.WriteString(symbols[s])
+			}
+			lines = append(lines, sb.String())
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// copyToClipboard copies the text with the clipboard command of the OS
+func copyToClipboard(text string) error {
+	input := []byte(text)
+	var commands [][]string
+	switch runtime.GOOS {
+	case "windows":
+		// clip reads its input in the console code page unless it starts with a UTF-16 byte order mark
+		input = utf16LE(text)
+		commands = [][]string{{"clip"}}
+	case "darwin":
+		commands = [][]string{{"pbcopy"}}
+	default:
+		commands = [][]string{{"wl-copy"}, {"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}}
+	}
+
+	var errs []error
+	for _, c := range commands {
+		cmd := exec.Command(c[0], c[1:]...)
+		cmd.Stdin = bytes.NewReader(input)
+		if err := cmd.Run(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return nil
+	}
+
+	return errors.Join(errs...)
+}
+
+// utf16LE encodes the text as UTF-16 little endian with a byte order mark
+func utf16LE(text string) []byte {
+	b := []byte{0xff, 0xfe}
+	for _, u := range utf16.Encode([]rune(text)) {
+		b = binary.LittleEndian.AppendUint16(b, u)
+	}
+
+	return b
+}
+
+// share copies the text to the clipboard, when there is no clipboard it is written to a file in the storage directory.
+// It returns the path of the file or an empty path when the text is copied to the clipboard.
+func share(text string) (string, error) {
+	if copyToClipboard(text) == nil {
+		return "", nil
+	}
+
+	dir, err := storageDir()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, shareFileName)
+
+	return path, os.WriteFile(path, []byte(text+"\n"), 0o644)
+}
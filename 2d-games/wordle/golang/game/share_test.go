@@ -0,0 +1,65 @@
+package wordle_test
+
+import (
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/game"
+)
+
+func TestShareHeader(t *testing.T) {
+	testCases := []struct {
+		language *core.Language
+		puzzle   int
+		boards   int
+		row      int
+		hardMode bool
+		expected string
+	}{
+		{core.Turkish, 123, 1, 3, false, "Wordle TR #123 4/6"},
+		{core.Turkish, 123, 1, 3, true, "Wordle TR #123 4/6*"},
+		{core.English, 0, 1, -1, false, "Wordle EN X/6"},
+		{core.English, 0, 4, 5, false, "Quordle EN 6/6"},
+		{core.Turkish, 7, 8, -1, false, "Octordle TR #7 X/6"},
+	}
+
+	for _, tc := range testCases {
+		if actual := wordle.ShareHeader(tc.language, tc.puzzle, tc.boards, tc.row, 6, tc.hardMode); actual != tc.expected {
+			t.Errorf("expected=%s actual=%s", tc.expected, actual)
+		}
+	}
+}
+
+func TestShareText(t *testing.T) {
+	results := [][]core.CharacterStatus{
+		core.Turkish.CheckAnswerRunes([]rune("ŞAPKA"), []rune("KAŞIK")),
+		core.Turkish.CheckAnswerRunes([]rune("KAŞIK"), []rune("KAŞIK")),
+	}
+
+	expected := "Wordle TR 2/6\n\n🟨🟩⬛🟨⬛\n🟩🟩🟩🟩🟩"
+	if actual := wordle.ShareText("Wordle TR 2/6", results, false); actual != expected {
+		t.Errorf("\nExpected:\n%s\nActual:\n%s", expected, actual)
+	}
+
+	expected = "Wordle TR 2/6\n\n🟦🟧⬛🟦⬛\n🟧🟧🟧🟧🟧"
+	if actual := wordle.ShareText("Wordle TR 2/6", results, true); actual != expected {
+		t.Errorf("\nExpected:\n%s\nActual:\n%s", expected, actual)
+	}
+}
+
+func TestShareBoardsText(t *testing.T) {
+	boards := [][][]core.CharacterStatus{
+		{
+			core.Turkish.CheckAnswerRunes([]rune("ŞAPKA"), []rune("KAŞIK")),
+			core.Turkish.CheckAnswerRunes([]rune("KAŞIK"), []rune("KAŞIK")),
+		},
+		{
+			core.Turkish.CheckAnswerRunes([]rune("ŞAPKA"), []rune("ŞAPKA")),
+		},
+	}
+
+	expected := "Dordle TR 2/7\n\n🟨🟩⬛🟨⬛\n🟩🟩🟩🟩🟩\n\n🟩🟩🟩🟩🟩"
+	if actual := wordle.ShareBoardsText("Dordle TR 2/7", boards, false); actual != expected {
+		t.Errorf("\nExpected:\n%s\nActual:\n%s", expected, actual)
+	}
+}
@@ -0,0 +1,208 @@
+package wordle
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const statsFileName = "stats.json"
+
+// Stats are the results of the finished games of one language, word length and mode
+type Stats struct {
+	Played        int `json:"played"`
+	Wins          int `json:"wins"`
+	CurrentStreak int `json:"currentStreak"`
+	MaxStreak     int `json:"maxStreak"`
+	// Distribution counts the wins by the row they were won on, index 0 is the first row
+	Distribution []int `json:"distribution"`
+	// LastRow is the row of the last win, -1 when the last game was lost
+	LastRow int `json:"lastRow"`
+}
+
+func NewStats() *Stats {
+	return &Stats{LastRow: -1}
+}
+
+// Record adds a finished game, row is the 0 based row of the winning guess
+func (s *Stats) Record(won bool, row int) {
+	s.Played++
+	s.LastRow = -1
+
+	if !won {
+		s.CurrentStreak = 0
+		return
+	}
+
+	s.Wins++
+	s.CurrentStreak++
+	s.MaxStreak = max(s.MaxStreak, s.CurrentStreak)
+	s.LastRow = row
+
+	for len(s.Distribution) <= row {
+		s.Distribution = append(s.Distribution, 0)
+	}
+	s.Distribution[row]++
+}
+
+func (s *Stats) WinPercentage() int {
+	if s.Played == 0 {
+		return 0
+	}
+
+	return s.Wins * 100 / s.Played
+}
+
+func statsKey(settings Settings) string {
+	if settings.Boards > 1 {
+		return fmt.Sprintf("%s-%d-%s-x%d", settings.Language.Name, settings.WordLength, settings.Mode, settings.Boards)
+	}
+
+	return fmt.Sprintf("%s-%d-%s", settings.Language.Name, settings.WordLength, settings.Mode)
+}
+
+// loadStats returns the stats of the settings from the stats file
+func loadStats(settings Settings) (*Stats, error) {
+	all := map[string]*Stats{}
+	if err := loadJSON(statsFileName, &all); err != nil {
+		return NewStats(), err
+	}
+
+	if s, ok := all[statsKey(settings)]; ok && s != nil {
+		return s, nil
+	}
+
+	return NewStats(), nil
+}
+
+// saveStats replaces the stats of the settings in the stats file, the stats of the other settings are kept
+func saveStats(settings Settings, s *Stats) error {
+	all := map[string]*Stats{}
+	if err := loadJSON(statsFileName, &all); err != nil {
+		return err
+	}
+
+	all[statsKey(settings)] = s
+
+	return saveJSON(statsFileName, all)
+}
+
+// gameResult is shown above the stats when the stats screen is opened at the end of a game
+type gameResult struct {
+	message string
+	color   color.Color
+	// detail is the line below the message, the playing time or the words of a run
+	detail string
+	// newGame shows the new game button, the daily puzzle cannot be played again
+	newGame bool
+}
+
+// statsScreen draws the stats and the guess distribution over the board
+type statsScreen struct {
+	title  *TextRenderer
+	value  *TextRenderer
+	label  *TextRenderer
+	bar    *TextRenderer
+	button *TextRenderer
+}
+
+func newStatsScreen() *statsScreen {
+	return &statsScreen{
+		title:  NewTextRenderer(RobotoBoldFontName, color.Black, 22),
+		value:  NewTextRenderer(RobotoBoldFontName, color.Black, 26),
+		label:  NewTextRenderer(RobotoRegularFontName, color.Black, 11),
+		bar:    NewTextRenderer(RobotoBoldFontName, color.White, 14),
+		button: NewTextRenderer(RobotoBoldFontName, color.White, 16),
+	}
+}
+
+// newGameButton returns the rect of the new game button at the bottom of the stats screen
+func newGameButton(width int) image.Rectangle {
+	return image.Rect(width/2-80, 540, width/2+80, 572)
+}
+
+func (s *statsScreen) draw(screen *ebiten.Image, stats *Stats, rows int, language *core.Language, result *gameResult) {
+	w := float32(screen.Bounds().Dx())
+	vector.DrawFilledRect(screen, 0, 0, w, float32(screen.Bounds().Dy()), color.RGBA{A: 128}, false)
+
+	if result == nil {
+		vector.DrawFilledRect(screen, 20, 60, w-40, 480, color.White, false)
+	} else {
+		// the panel grows to show the result above the stats and the button below them
+		vector.DrawFilledRect(screen, 20, 15, w-40, 570, color.White, false)
+
+		s.title.SetColor(result.color)
+		s.title.Draw(screen, result.message, int(w)/2, 32)
+		s.title.SetColor(color.Black)
+		s.label.Draw(screen, result.detail, int(w)/2, 52)
+
+		if result.newGame {
+			b := newGameButton(int(w))
+			vector.DrawFilledRect(screen, float32(b.Min.X), float32(b.Min.Y), float32(b.Dx()), float32(b.Dy()), greenColor, false)
+			s.button.Draw(screen, language.Message(core.MessageNewGame), b.Min.X+b.Dx()/2, b.Min.Y+b.Dy()/2)
+		}
+	}
+
+	s.title.Draw(screen, language.Message(core.MessageStatistics), int(w)/2, 90)
+
+	columns := []struct {
+		value int
+		label core.MessageKey
+	}{
+		{stats.Played, core.MessagePlayed},
+		{stats.WinPercentage(), core.MessageWinPercentage},
+		{stats.CurrentStreak, core.MessageCurrentStreak},
+		{stats.MaxStreak, core.MessageMaxStreak},
+	}
+
+	// the columns share the width of the panel, which is wider on the screens with more boards
+	panelW := int(w) - 40
+	for i, c := range columns {
+		x := 20 + panelW*(2*i+1)/(2*len(columns))
+		s.value.Draw(screen, strconv.Itoa(c.value), x, 145)
+		s.label.Draw(screen, language.Message(c.label), x, 175)
+	}
+
+	s.title.Draw(screen, language.Message(core.MessageGuessDistribution), int(w)/2, 225)
+
+	most := 1
+	for _, n := range stats.Distribution {
+		most = max(most, n)
+	}
+
+	top := 255
+	h := min(24, (520-top)/rows-4)
+	for row := 0; row < rows; row++ {
+		n := 0
+		if row < len(stats.Distribution) {
+			n = stats.Distribution[row]
+		}
+
+		y := top + row*(h+4)
+		barW := max(20, 270*n/most)
+
+		clr := grayColor
+		if row == stats.LastRow {
+			clr = greenColor
+		}
+
+		s.bar.SetColor(color.Black)
+		s.bar.Draw(screen, strconv.Itoa(row+1), 50, y+h/2)
+		vector.DrawFilledRect(screen, 65, float32(y), float32(barW), float32(h), clr, false)
+		s.bar.SetColor(color.White)
+		s.bar.Draw(screen, strconv.Itoa(n), 65+barW-10, y+h/2)
+	}
+}
+
+// formatElapsed formats the playing time like 2:05
+func formatElapsed(d time.Duration) string {
+	seconds := int(d.Seconds())
+
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,36 @@
+package wordle_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/game"
+)
+
+func TestStatsRecord(t *testing.T) {
+	s := wordle.NewStats()
+	s.Record(true, 3)
+	s.Record(true, 1)
+	s.Record(false, 0)
+	s.Record(true, 3)
+
+	if s.Played != 4 || s.Wins != 3 {
+		t.Errorf("played=%d wins=%d", s.Played, s.Wins)
+	}
+
+	if s.CurrentStreak != 1 || s.MaxStreak != 2 {
+		t.Errorf("current streak=%d max streak=%d", s.CurrentStreak, s.MaxStreak)
+	}
+
+	if s.WinPercentage() != 75 {
+		t.Errorf("win percentage expected=75 actual=%d", s.WinPercentage())
+	}
+
+	if expected := []int{0, 1, 0, 2}; !slices.Equal(s.Distribution, expected) {
+		t.Errorf("distribution expected=%v actual=%v", expected, s.Distribution)
+	}
+
+	if s.LastRow != 3 {
+		t.Errorf("last row expected=3 actual=%d", s.LastRow)
+	}
+}
@@ -1,40 +1,22 @@
 package wordle
 
-import "image/color"
+import (
+	"image/color"
 
-type CharacterStatus int
-
-const (
-	CharacterStatusNone CharacterStatus = iota
-	CharacterStatusNotPresent
-	CharacterStatusWrongLocation
-	CharacterStatusCorrectLocation
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
 )
 
-func (s *CharacterStatus) getColor() color.Color {
+func statusColor(s core.CharacterStatus) color.Color {
 	var r color.Color = color.White
 
-	switch *s {
-	case CharacterStatusWrongLocation:
+	switch s {
+	case core.CharacterStatusWrongLocation:
 		r = yellowColor
-	case CharacterStatusNotPresent:
+	case core.CharacterStatusNotPresent:
 		r = grayColor
-	case CharacterStatusCorrectLocation:
+	case core.CharacterStatusCorrectLocation:
 		r = greenColor
 	}
 
 	return r
 }
-
-func (s CharacterStatus) String() string {
-	switch s {
-	case CharacterStatusWrongLocation:
-		return "CharacterStatusWrongLocation"
-	case CharacterStatusNotPresent:
-		return "CharacterStatusNotPresent"
-	case CharacterStatusCorrectLocation:
-		return "CharacterStatusCorrectLocation"
-	default:
-		return "unknown"
-	}
-}
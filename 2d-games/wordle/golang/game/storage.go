@@ -0,0 +1,68 @@
+package wordle
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// storageDir returns the directory the game keeps its files in
+func storageDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "wordle"), nil
+}
+
+// loadJSON reads the named file from the storage directory into v, a missing file leaves v unchanged
+func loadJSON(name string, v any) error {
+	dir, err := storageDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func saveJSON(name string, v any) error {
+	dir, err := storageDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, name), data, 0o644)
+}
+
+// removeJSON deletes the named file from the storage directory, a missing file is not an error
+func removeJSON(name string) error {
+	dir, err := storageDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filepath.Join(dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
@@ -4,8 +4,8 @@ import (
 	"container/list"
 	"image/color"
 	"math"
-	"unicode"
 
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -21,7 +21,7 @@ type tile struct {
 	col    int
 	row    int
 	r      rune
-	status CharacterStatus
+	status core.CharacterStatus
 
 	text            *TextRenderer
 	fontColor       color.Color
@@ -36,16 +36,16 @@ type tile struct {
 }
 
 func newTile(col, row int, board *board) *tile {
-	size := 60
+	size := board.tileSize
 
 	return &tile{
-		x:               44 + float64(col*(size+3)+3),
-		y:               float64(row*(size+3) + 3),
+		x:               board.tileX + float64(col*(size+tileGap)),
+		y:               board.tileY + float64(row*(size+tileGap)),
 		size:            size,
 		col:             col,
 		row:             row,
 		board:           board,
-		text:            NewTextRenderer(RobotoBoldFontName, color.Black, 30),
+		text:            NewTextRenderer(RobotoBoldFontName, color.Black, size/2),
 		fontColor:       color.Black,
 		borderColor:     lightGrayColor,
 		backgroundColor: color.White,
@@ -88,7 +88,7 @@ func (t *tile) Draw(screen *ebiten.Image) {
 	r := t.r
 	if r != 0 {
 		t.text.SetColor(t.fontColor)
-		t.text.Draw(innerRect, string(unicode.TurkishCase.ToUpper(r)), innerRect.Bounds().Dx()/2, innerRect.Bounds().Dy()/2)
+		t.text.Draw(innerRect, string(r), innerRect.Bounds().Dx()/2, innerRect.Bounds().Dy()/2)
 	}
 
 	op := &ebiten.DrawImageOptions{}
@@ -136,15 +136,15 @@ func (t *tile) setRune(r rune) {
 }
 
 func (t *tile) clearStatus() {
-	t.setStatus(CharacterStatusNone)
+	t.setStatus(core.CharacterStatusNone)
 }
 
-func (t *tile) setStatus(s CharacterStatus) {
+func (t *tile) setStatus(s core.CharacterStatus) {
 	t.status = s
 }
 
 func (t *tile) isCharStatusNone() bool {
-	return t.status == CharacterStatusNone
+	return t.status == core.CharacterStatusNone
 }
 
 func (t *tile) isEmpty() bool {
@@ -199,7 +199,7 @@ func (t *tile) updateBackgroundColor() {
 	if t.flipped {
 		st := t.status
 
-		t.backgroundColor = st.getColor()
+		t.backgroundColor = statusColor(st)
 	} else {
 		t.backgroundColor = color.White
 	}
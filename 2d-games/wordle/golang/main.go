@@ -2,10 +2,14 @@ package main
 
 import (
 	"bytes"
+	"flag"
+	"fmt"
 	"image"
 	"image/png"
 	"log"
+	"strings"
 
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
 	wordle "github.com/DTVegaArchChapter/GameProgramming/wordle/game"
 	"github.com/hajimehoshi/ebiten/v2"
 
@@ -16,16 +20,76 @@ import (
 var iconData []byte
 
 func main() {
+	settings := wordle.DefaultSettings()
+	lang := flag.String("lang", settings.Language.Name, fmt.Sprintf("language of the words and the keyboard (%s), F1 switches it in game", strings.Join(core.LanguageNames(), ", ")))
+	flag.IntVar(&settings.WordLength, "length", settings.WordLength, fmt.Sprintf("word length, %d-%d, the Turkish word lists only have 5 letter words", core.MinWordLength, core.MaxWordLength))
+	flag.IntVar(&settings.Guesses, "guesses", settings.Guesses, fmt.Sprintf("number of guesses, %d-%d, Quordle allows up to %d and Octordle up to %d, the default is the number of boards + 5", wordle.MinGuesses, wordle.MaxGuesses, wordle.MaxBoardGuesses(4), wordle.MaxBoardGuesses(8)))
+	flag.IntVar(&settings.Boards, "boards", settings.Boards, fmt.Sprintf("number of words guessed at once, one of %v", wordle.BoardCounts))
+	flag.StringVar(&settings.Mode, "mode", settings.Mode, fmt.Sprintf("%s: a random word every game, %s: one puzzle a day, %s: no fixed answer, the game dodges the guesses, %s: as many words as possible before the time is up, %s: every guess against the clock", wordle.ModePractice, wordle.ModeDaily, wordle.ModeAbsurdle, wordle.ModeTimed, wordle.ModeSpeed))
+	flag.DurationVar(&settings.TimeLimit, "time", settings.TimeLimit, "length of a timed run (3m by default) or the time of a guess in speed mode (20s by default)")
+	flag.BoolVar(&settings.HardMode, "hard", settings.HardMode, "hard mode, every guess has to use the revealed hints")
+	dictPath := flag.String("dict", "", "dictionary file to use instead of the built-in dictionary of the language")
+	targetsPath := flag.String("targets", "", "targets file to use instead of the built-in targets of the language")
+	packs := flag.String("packs", "", "folder of language packs like packs/en/dict.txt and packs/en/target.txt")
+	flag.BoolVar(&settings.ColorBlind, "colorblind", settings.ColorBlind, "share results with colour blind friendly symbols")
+	flag.Parse()
+
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	if !set["guesses"] {
+		settings.Guesses = wordle.DefaultGuesses(settings.Boards)
+	}
+
+	if *packs != "" {
+		if err := core.LoadLanguagePacks(*packs); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	language, err := core.GetLanguage(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *dictPath != "" || *targetsPath != "" {
+		if language, err = language.LoadWordLists(*dictPath, *targetsPath); err != nil {
+			log.Fatal(err)
+		}
+		core.RegisterLanguage(language)
+	}
+	settings.Language = language
+
+	// without game settings on the command line the saved game is resumed with the settings it was played with
+	gameFlags := set["lang"] || set["length"] || set["guesses"] || set["boards"] || set["mode"] || set["hard"] || set["dict"] || set["targets"]
+	if !gameFlags {
+		if saved, err := wordle.LoadSavedGame(); err != nil {
+			log.Printf("saved game could not be loaded: %s", err)
+		} else if saved != nil {
+			if s, err := saved.Settings(settings); err != nil {
+				log.Printf("saved game could not be resumed: %s", err)
+			} else {
+				settings = s
+			}
+		}
+	}
+
 	// Decode the embedded PNG data
 	icon, err := png.Decode(bytes.NewReader(iconData))
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	game := wordle.NewGame()
-	ebiten.SetWindowSize(wordle.ScreenWidth, wordle.ScreenHeight)
-	ebiten.SetWindowTitle("Türkçe Wordle")
+	game, err := wordle.NewGame(settings)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ebiten.SetWindowSize(game.Size())
 	ebiten.SetWindowIcon([]image.Image{icon})
+	// the game in progress is saved when the window is closed
+	ebiten.SetWindowClosingHandled(true)
 
 	if err := ebiten.RunGame(game); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,285 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+// maxBodySize limits the size of the request bodies, the requests are a few small fields
+const maxBodySize = 1 << 12
+
+// Server is the HTTP/JSON API of the game. The games are kept in memory and forgotten once they
+// are not used for the time to live, every request to a game starts its time to live again.
+//
+//	POST /games                 starts a game, {"language": "tr", "length": 5, "guesses": 6, "mode": "practice", "hard": false}
+//	GET  /games/{id}            returns the game
+//	POST /games/{id}/guesses    submits a guess, {"guess": "kalem"}
+//
+// The answer is only in the response once the game is finished.
+type Server struct {
+	ttl time.Duration
+	mux *http.ServeMux
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	// dicts caches the dictionaries by language and word length
+	dicts map[string]*core.Dictionary
+}
+
+type session struct {
+	id       string
+	language string
+	mode     string
+	hardMode bool
+	board    *core.Board
+	expires  time.Time
+}
+
+type newGameRequest struct {
+	Language string `json:"language"`
+	Length   int    `json:"length"`
+	Guesses  int    `json:"guesses"`
+	Mode     string `json:"mode"`
+	HardMode bool   `json:"hard"`
+}
+
+type guessRequest struct {
+	Guess string `json:"guess"`
+}
+
+// GameResponse is the state of a game, it is the response of every successful request
+type GameResponse struct {
+	ID         string          `json:"id"`
+	Language   string          `json:"language"`
+	Length     int             `json:"length"`
+	MaxGuesses int             `json:"maxGuesses"`
+	Mode       string          `json:"mode"`
+	HardMode   bool            `json:"hard"`
+	State      core.State      `json:"state"`
+	Guesses    []GuessResponse `json:"guesses"`
+	// Answer is empty while the game is in progress
+	Answer    string    `json:"answer,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type GuessResponse struct {
+	Word   string                 `json:"word"`
+	Result []core.CharacterStatus `json:"result"`
+}
+
+// ErrorResponse is the response of a failed request, the rejected guesses are explained in the language of the game
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// New returns a server which forgets the games not used for ttl
+func New(ttl time.Duration) *Server {
+	s := &Server{
+		ttl:      ttl,
+		mux:      http.NewServeMux(),
+		sessions: map[string]*session{},
+		dicts:    map[string]*core.Dictionary{},
+	}
+
+	s.mux.HandleFunc("POST /games", s.newGame)
+	s.mux.HandleFunc("GET /games/{id}", s.getGame)
+	s.mux.HandleFunc("POST /games/{id}/guesses", s.guess)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) newGame(w http.ResponseWriter, r *http.Request) {
+	req := newGameRequest{Language: core.Turkish.Name, Length: 5, Guesses: 6, Mode: core.ModePractice}
+	if err := decode(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	language, err := core.GetLanguage(req.Language)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dict, err := s.dictionary(language, req.Length)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	board, err := core.NewModeBoard(dict, req.Mode, req.Guesses, req.HardMode, time.Now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := newID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.removeExpired()
+	game := &session{id: id, language: language.Name, mode: req.Mode, hardMode: req.HardMode, board: board}
+	s.sessions[id] = game
+	s.touch(game)
+
+	writeJSON(w, http.StatusCreated, game.response())
+}
+
+func (s *Server) getGame(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	game, ok := s.session(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("game not found"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, game.response())
+}
+
+func (s *Server) guess(w http.ResponseWriter, r *http.Request) {
+	var req guessRequest
+	if err := decode(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	game, ok := s.session(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("game not found"))
+		return
+	}
+
+	if game.board.State() != core.StateInProgress {
+		writeError(w, http.StatusConflict, errors.New("the game is over"))
+		return
+	}
+
+	if _, err := game.board.Submit(req.Guess); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, game.response())
+}
+
+// session returns the game with the id unless it expired, the time to live of the game starts again
+func (s *Server) session(id string) (*session, bool) {
+	game, ok := s.sessions[id]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(game.expires) {
+		delete(s.sessions, id)
+		return nil, false
+	}
+
+	s.touch(game)
+
+	return game, true
+}
+
+func (s *Server) touch(game *session) {
+	game.expires = time.Now().Add(s.ttl)
+}
+
+func (s *Server) removeExpired() {
+	now := time.Now()
+	for id, game := range s.sessions {
+		if now.After(game.expires) {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+func (s *Server) dictionary(language *core.Language, length int) (*core.Dictionary, error) {
+	key := fmt.Sprintf("%s-%d", language.Name, length)
+	if d, ok := s.dicts[key]; ok {
+		return d, nil
+	}
+
+	d, err := core.NewLanguageDictionary(language, length)
+	if err != nil {
+		return nil, err
+	}
+	s.dicts[key] = d
+
+	return d, nil
+}
+
+func (g *session) response() GameResponse {
+	b := g.board
+	res := GameResponse{
+		ID:         g.id,
+		Language:   g.language,
+		Length:     b.WordLength(),
+		MaxGuesses: b.MaxGuesses(),
+		Mode:       g.mode,
+		HardMode:   g.hardMode,
+		State:      b.State(),
+		Guesses:    []GuessResponse{},
+		ExpiresAt:  g.expires,
+	}
+
+	for i, w := range b.Guesses() {
+		res.Guesses = append(res.Guesses, GuessResponse{Word: w, Result: b.Results()[i]})
+	}
+
+	if b.State() != core.StateInProgress {
+		res.Answer = b.Answer()
+	}
+
+	return res
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// decode reads the JSON body of the request into v, an empty body keeps v unchanged
+func decode(w http.ResponseWriter, r *http.Request, v any) error {
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(v)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
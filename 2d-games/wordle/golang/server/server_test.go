@@ -0,0 +1,152 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/server"
+)
+
+func request(t *testing.T, s http.Handler, method, path, body string, status int, v any) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+
+	if rec.Code != status {
+		t.Fatalf("%s %s expected status %d, got %d: %s", method, path, status, rec.Code, rec.Body)
+	}
+
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("%s %s: %s", method, path, err)
+	}
+}
+
+// dailyAnswer returns the answer of the English daily game started now and a target which is not the answer
+func dailyAnswer(t *testing.T) (answer, other string) {
+	dict, err := core.NewLanguageDictionary(core.English, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	answer = dict.DailyWord(core.DailyPuzzleNumber(time.Now()))
+	for _, w := range dict.Targets() {
+		if w != answer {
+			return answer, w
+		}
+	}
+
+	return answer, ""
+}
+
+func TestNewGame(t *testing.T) {
+	s := server.New(time.Minute)
+
+	var game server.GameResponse
+	request(t, s, "POST", "/games", "", http.StatusCreated, &game)
+
+	if game.ID == "" || game.Language != "tr" || game.Length != 5 || game.MaxGuesses != 6 || game.Mode != core.ModePractice {
+		t.Errorf("unexpected default game %+v", game)
+	}
+
+	if game.State != core.StateInProgress || game.Answer != "" || len(game.Guesses) != 0 {
+		t.Errorf("a new game should not show the answer: %+v", game)
+	}
+
+	var fetched server.GameResponse
+	request(t, s, "GET", "/games/"+game.ID, "", http.StatusOK, &fetched)
+	if fetched.ID != game.ID {
+		t.Errorf("expected game %s, got %s", game.ID, fetched.ID)
+	}
+}
+
+func TestGuess(t *testing.T) {
+	s := server.New(time.Minute)
+	answer, other := dailyAnswer(t)
+
+	var game server.GameResponse
+	request(t, s, "POST", "/games", `{"language": "en", "mode": "daily"}`, http.StatusCreated, &game)
+
+	var e server.ErrorResponse
+	request(t, s, "POST", "/games/"+game.ID+"/guesses", `{"guess": "zzzzz"}`, http.StatusUnprocessableEntity, &e)
+	if e.Error != core.English.Message(core.MessageNotInWordList) {
+		t.Errorf("unexpected error %s", e.Error)
+	}
+
+	request(t, s, "POST", "/games/"+game.ID+"/guesses", `{"guess": "`+strings.ToLower(other)+`"}`, http.StatusOK, &game)
+	expected := core.English.CheckAnswerRunes([]rune(other), []rune(answer))
+	if len(game.Guesses) != 1 || game.Guesses[0].Word != other || !slices.Equal(game.Guesses[0].Result, expected) {
+		t.Errorf("expected %s %v, got %+v", other, expected, game.Guesses)
+	}
+
+	if game.State != core.StateInProgress || game.Answer != "" {
+		t.Errorf("the answer should be hidden while the game is in progress: %+v", game)
+	}
+
+	request(t, s, "POST", "/games/"+game.ID+"/guesses", `{"guess": "`+answer+`"}`, http.StatusOK, &game)
+	if game.State != core.StateWon || game.Answer != answer {
+		t.Errorf("expected a won game with the answer %s, got %+v", answer, game)
+	}
+
+	request(t, s, "POST", "/games/"+game.ID+"/guesses", `{"guess": "`+answer+`"}`, http.StatusConflict, &e)
+}
+
+func TestLost(t *testing.T) {
+	s := server.New(time.Minute)
+	answer, other := dailyAnswer(t)
+
+	var game server.GameResponse
+	request(t, s, "POST", "/games", `{"language": "en", "mode": "daily", "guesses": 4}`, http.StatusCreated, &game)
+	for range 4 {
+		request(t, s, "POST", "/games/"+game.ID+"/guesses", `{"guess": "`+other+`"}`, http.StatusOK, &game)
+	}
+
+	if game.State != core.StateLost || game.Answer != answer {
+		t.Errorf("expected a lost game with the answer %s, got %+v", answer, game)
+	}
+}
+
+func TestBadRequests(t *testing.T) {
+	s := server.New(time.Minute)
+
+	testCases := []struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"POST", "/games", `{"language": "xx"}`, http.StatusBadRequest},
+		{"POST", "/games", `{"length": 12}`, http.StatusBadRequest},
+		{"POST", "/games", `{"guesses": 20}`, http.StatusBadRequest},
+		{"POST", "/games", `{"mode": "timed"}`, http.StatusBadRequest},
+		{"POST", "/games", `{"language": `, http.StatusBadRequest},
+		{"GET", "/games/unknown", "", http.StatusNotFound},
+		{"POST", "/games/unknown/guesses", `{"guess": "kalem"}`, http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		var e server.ErrorResponse
+		request(t, s, tc.method, tc.path, tc.body, tc.status, &e)
+		if e.Error == "" {
+			t.Errorf("%s %s %s: expected an error message", tc.method, tc.path, tc.body)
+		}
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	s := server.New(20 * time.Millisecond)
+
+	var game server.GameResponse
+	request(t, s, "POST", "/games", "", http.StatusCreated, &game)
+
+	time.Sleep(50 * time.Millisecond)
+
+	var e server.ErrorResponse
+	request(t, s, "GET", "/games/"+game.ID, "", http.StatusNotFound, &e)
+}
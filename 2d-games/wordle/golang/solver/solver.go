@@ -0,0 +1,234 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+)
+
+// Guess is a scored guess, Result is the feedback of the game for Word
+type Guess struct {
+	Word   string
+	Result []core.CharacterStatus
+}
+
+// Solved reports whether the guess is the answer
+func (g Guess) Solved() bool {
+	for _, s := range g.Result {
+		if s != core.CharacterStatusCorrectLocation {
+			return false
+		}
+	}
+
+	return len(g.Result) > 0
+}
+
+// Suggestion is a guess ranked by the expected information of its feedback in bits
+type Suggestion struct {
+	Word    string
+	Entropy float64
+	// Candidate is true when the word can still be the answer
+	Candidate bool
+}
+
+type Solver struct {
+	dict *core.Dictionary
+	// HardMode only suggests the guesses which use the revealed hints
+	HardMode bool
+
+	// best caches the best guess of the played guesses, the solver is deterministic so
+	// every game with the same feedback so far continues with the same guess
+	best map[string]string
+	// mu guards best, Best can be called from several goroutines
+	mu sync.Mutex
+}
+
+func New(dict *core.Dictionary) *Solver {
+	return &Solver{dict: dict, best: map[string]string{}}
+}
+
+// Candidates returns the targets which give the same feedback as the answer for every guess
+func (s *Solver) Candidates(guesses []Guess) []string {
+	var result []string
+	for _, t := range s.dict.Targets() {
+		if Consistent(t, guesses) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// Consistent reports whether word can be the answer of the scored guesses
+func Consistent(word string, guesses []Guess) bool {
+	w := []rune(word)
+	for _, g := range guesses {
+		if score([]rune(g.Word), w) != pattern(g.Result) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rank returns the n guesses from the dictionary which split the candidates the most.
+// On equal entropy the words which can be the answer come first.
+func (s *Solver) Rank(guesses []Guess, n int) []Suggestion {
+	candidates := s.Candidates(guesses)
+	if len(candidates) <= 2 {
+		// guessing a candidate is never worse when at most two words are left
+		result := make([]Suggestion, len(candidates))
+		for i, c := range candidates {
+			result[i] = Suggestion{Word: c, Entropy: 1, Candidate: true}
+		}
+
+		return result[:min(n, len(result))]
+	}
+
+	hints := core.NewHints(len([]rune(candidates[0])))
+	for _, g := range guesses {
+		hints.Add([]rune(g.Word), g.Result)
+	}
+
+	isCandidate := make(map[string]bool, len(candidates))
+	answers := make([][]rune, len(candidates))
+	for i, c := range candidates {
+		isCandidate[c] = true
+		answers[i] = []rune(c)
+	}
+
+	var result []Suggestion
+	counts := make([]int, 1<<(2*len(answers[0])))
+	var patterns []int
+	for _, w := range s.dict.Words {
+		guess := []rune(w)
+		if _, violates := hints.Violation(guess); s.HardMode && violates {
+			continue
+		}
+
+		patterns = patterns[:0]
+		for _, a := range answers {
+			p := score(guess, a)
+			if counts[p] == 0 {
+				patterns = append(patterns, p)
+			}
+			counts[p]++
+		}
+
+		e := 0.0
+		for _, p := range patterns {
+			c := float64(counts[p]) / float64(len(answers))
+			e -= c * math.Log2(c)
+			counts[p] = 0
+		}
+
+		result = append(result, Suggestion{Word: w, Entropy: e, Candidate: isCandidate[w]})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Entropy != result[j].Entropy {
+			return result[i].Entropy > result[j].Entropy
+		}
+
+		return result[i].Candidate && !result[j].Candidate
+	})
+
+	return result[:min(n, len(result))]
+}
+
+// Best returns the best next guess, it is empty when no target fits the guesses
+func (s *Solver) Best(guesses []Guess) string {
+	var key strings.Builder
+	for _, g := range guesses {
+		fmt.Fprintf(&key, "%s:%d ", g.Word, pattern(g.Result))
+	}
+
+	s.mu.Lock()
+	w, ok := s.best[key.String()]
+	s.mu.Unlock()
+	if ok {
+		return w
+	}
+
+	if r := s.Rank(guesses, 1); len(r) > 0 {
+		w = r[0].Word
+	}
+
+	s.mu.Lock()
+	s.best[key.String()] = w
+	s.mu.Unlock()
+
+	return w
+}
+
+// Solve plays the game against the answer and returns the guesses of the solver,
+// the last guess is the answer unless maxGuesses were not enough
+func (s *Solver) Solve(answer string, maxGuesses int) []Guess {
+	a := []rune(answer)
+
+	return s.Play(func(guess []rune) []core.CharacterStatus {
+		return s.dict.Language().CheckAnswerRunes(guess, a)
+	}, maxGuesses)
+}
+
+// Play plays the game with the given scoring of the guesses, it is used to play against a core.Adversary
+func (s *Solver) Play(check func(guess []rune) []core.CharacterStatus, maxGuesses int) []Guess {
+	var guesses []Guess
+	for len(guesses) < maxGuesses {
+		w := s.Best(guesses)
+		if w == "" {
+			break
+		}
+
+		guesses = append(guesses, Guess{Word: w, Result: check([]rune(w))})
+		if guesses[len(guesses)-1].Solved() {
+			break
+		}
+	}
+
+	return guesses
+}
+
+// pattern packs the feedback of a guess into a number, two results are equal when their patterns are equal
+func pattern(result []core.CharacterStatus) int {
+	p := 0
+	for _, s := range result {
+		p = p*4 + int(s)
+	}
+
+	return p
+}
+
+// score returns the pattern of Language.CheckAnswerRunes(guess, answer) without allocating, the words are upper-case
+func score(guess, answer []rune) int {
+	var result [core.MaxWordLength]core.CharacterStatus
+	var used [core.MaxWordLength]bool
+
+	for i := range guess {
+		if guess[i] == answer[i] {
+			result[i] = core.CharacterStatusCorrectLocation
+			used[i] = true
+		}
+	}
+
+	for i := range guess {
+		if result[i] == core.CharacterStatusCorrectLocation {
+			continue
+		}
+
+		result[i] = core.CharacterStatusNotPresent
+		for j := range answer {
+			if !used[j] && guess[i] == answer[j] {
+				result[i] = core.CharacterStatusWrongLocation
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	return pattern(result[:len(guess)])
+}
@@ -0,0 +1,142 @@
+package solver_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/core"
+	"github.com/DTVegaArchChapter/GameProgramming/wordle/solver"
+)
+
+func guess(word, answer string) solver.Guess {
+	return solver.Guess{Word: word, Result: core.Turkish.CheckAnswerRunes([]rune(word), []rune(answer))}
+}
+
+func TestConsistent(t *testing.T) {
+	testCases := []struct {
+		word     string
+		guesses  []solver.Guess
+		expected bool
+	}{
+		{"KAŞIK", nil, true},
+		{"KAŞIK", []solver.Guess{guess("ŞAPKA", "KAŞIK")}, true},
+		{"KAŞIK", []solver.Guess{guess("ŞAPKA", "KAŞIK"), guess("KİRLİ", "KAŞIK")}, true},
+		{"KAPAK", []solver.Guess{guess("ŞAPKA", "KAŞIK")}, false},
+		// the second A of ANNAA is not present since the answer has only one
+		{"AABBB", []solver.Guess{guess("ANNAA", "AABBB")}, true},
+		{"AAABB", []solver.Guess{guess("ANNAA", "AABBB")}, false},
+	}
+
+	for _, tc := range testCases {
+		if actual := solver.Consistent(tc.word, tc.guesses); actual != tc.expected {
+			t.Errorf("Consistent(%s, %v) expected=%v actual=%v", tc.word, tc.guesses, tc.expected, actual)
+		}
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	dict := core.NewDictionary()
+	s := solver.New(dict)
+
+	if c := s.Candidates(nil); len(c) != len(dict.Targets()) {
+		t.Errorf("without guesses every target is a candidate, expected=%d actual=%d", len(dict.Targets()), len(c))
+	}
+
+	guesses := []solver.Guess{guess("ŞAPKA", "KAÇIŞ"), guess("KARIN", "KAÇIŞ")}
+	candidates := s.Candidates(guesses)
+	if !slices.Contains(candidates, "KAÇIŞ") {
+		t.Errorf("candidates %v should contain the answer", candidates)
+	}
+
+	for _, c := range candidates {
+		for _, g := range guesses {
+			if !slices.Equal(core.Turkish.CheckAnswerRunes([]rune(g.Word), []rune(c)), g.Result) {
+				t.Errorf("candidate %s does not give the feedback of %s", c, g.Word)
+			}
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	s := solver.New(core.NewDictionary())
+
+	guesses := []solver.Guess{guess("ŞAPKA", "KAŞIK")}
+	ranked := s.Rank(guesses, 10)
+	if len(ranked) != 10 {
+		t.Fatalf("expected 10 suggestions, got %d", len(ranked))
+	}
+
+	for i := 1; i < len(ranked); i++ {
+		if ranked[i].Entropy > ranked[i-1].Entropy {
+			t.Errorf("suggestions are not sorted by entropy: %v", ranked)
+		}
+	}
+
+	if s.Best(guesses) != ranked[0].Word {
+		t.Errorf("best guess %s should be the first suggestion %s", s.Best(guesses), ranked[0].Word)
+	}
+}
+
+func TestRankHardMode(t *testing.T) {
+	s := solver.New(core.NewDictionary())
+	s.HardMode = true
+
+	guesses := []solver.Guess{guess("ŞAPKA", "KAŞIK")}
+	hints := core.NewHints(5)
+	hints.Add([]rune(guesses[0].Word), guesses[0].Result)
+
+	for _, r := range s.Rank(guesses, 20) {
+		if v, ok := hints.Violation([]rune(r.Word)); ok {
+			t.Errorf("hard mode suggestion %s does not use the hint %v", r.Word, v)
+		}
+	}
+}
+
+func TestBestConcurrent(t *testing.T) {
+	s := solver.New(core.NewDictionary())
+	guesses := []solver.Guess{guess("ŞAPKA", "KAŞIK")}
+
+	results := make(chan string, 4)
+	for range cap(results) {
+		go func() { results <- s.Best(guesses) }()
+	}
+
+	expected := s.Best(guesses)
+	for range cap(results) {
+		if w := <-results; w != expected {
+			t.Errorf("concurrent Best expected=%s actual=%s", expected, w)
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	for _, language := range []*core.Language{core.Turkish, core.English} {
+		dict, err := core.NewLanguageDictionary(language, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := solver.New(dict)
+		for i, answer := range dict.Targets() {
+			if i%25 != 0 {
+				continue
+			}
+
+			guesses := s.Solve(answer, 6)
+			if len(guesses) == 0 || guesses[len(guesses)-1].Word != answer {
+				t.Errorf("solver could not find %s in 6 guesses: %v", answer, guesses)
+			}
+		}
+	}
+}
+
+func TestPlayAdversary(t *testing.T) {
+	dict := core.NewDictionary()
+	s := solver.New(dict)
+
+	adversary := core.NewAdversary(dict.Language(), dict.Targets())
+	guesses := s.Play(adversary.Check, 10)
+	if len(guesses) == 0 || !guesses[len(guesses)-1].Solved() {
+		t.Errorf("solver could not beat the adversary in 10 guesses: %v", guesses)
+	}
+}